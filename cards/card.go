@@ -12,6 +12,13 @@ type PlayingCardType int
 
 const (
 	French PlayingCardType = iota
+	Spanish
+	Italian
+	German
+	Tarot
+	Custom
+	Uno
+	Hanafuda
 )
 
 // String returns a stringified version of a PlayingCardType.
@@ -19,6 +26,20 @@ func (cardType PlayingCardType) String() string {
 	switch cardType {
 	case French:
 		return "French"
+	case Spanish:
+		return "Spanish"
+	case Italian:
+		return "Italian"
+	case German:
+		return "German"
+	case Tarot:
+		return "Tarot"
+	case Custom:
+		return "Custom"
+	case Uno:
+		return "Uno"
+	case Hanafuda:
+		return "Hanafuda"
 	}
 	return "Undefined"
 }
@@ -33,10 +54,34 @@ type Card interface {
 
 // PlayingCard is the representation of a card entity.
 // PlayingCard should be defined in any specific type of card.
+// DeckIndex is the 1-based index of the deck the card originates from when several decks are
+// combined; DeckIndex is zero otherwise.
+// CopyIndex is the 1-based index of the copy of the card when a single deck contains identical
+// cards, e.g. a Pinochle deck, so that identical cards remain traceable; CopyIndex is zero otherwise.
+// Attributes holds the information carried by the card beyond its suit and value, e.g. the
+// category of a Hanafuda card or the arbitrary attributes of the cards of a user-defined deck;
+// Attributes is shared by the copies of a card and must not be modified.
+// Metadata holds the structured information used to score the card, e.g. of a French-suited card;
+// Metadata is shared by the copies of a card and must not be modified.
 type PlayingCard struct {
-	Value string `json:"value"`
-	Suit  string `json:"suit"`
-	Code  string `json:"code"`
+	Value      string            `json:"value"`
+	Suit       string            `json:"suit"`
+	Code       string            `json:"code"`
+	DeckIndex  int               `json:"deck_index,omitempty"`
+	CopyIndex  int               `json:"copy_index,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Metadata   *CardMetadata     `json:"metadata,omitempty"`
+}
+
+// CardMetadata is the representation of the structured information used to score a card.
+// Rank is the ordinal of the value of the card among the values of its type, starting at 1.
+// Color is the color of the card, and Face is true if the card depicts a figure.
+// Points is the number of points the card is worth.
+type CardMetadata struct {
+	Rank   int    `json:"rank"`
+	Color  string `json:"color"`
+	Face   bool   `json:"face"`
+	Points int    `json:"points"`
 }
 
 var _ Card = PlayingCard{}
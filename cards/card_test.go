@@ -12,6 +12,13 @@ func TestPlayingCardStringifiedTypes(t *testing.T) {
 	}{
 		{PlayingCardType(9999), "Undefined"},
 		{French, "French"},
+		{Spanish, "Spanish"},
+		{Italian, "Italian"},
+		{German, "German"},
+		{Tarot, "Tarot"},
+		{Custom, "Custom"},
+		{Uno, "Uno"},
+		{Hanafuda, "Hanafuda"},
 	}
 	for _, testRecord := range testRecords {
 		assert.Equal(t, testRecord.expectedStringifiedType, testRecord.playingCardType.String(), "expected identical enum representation")
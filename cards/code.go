@@ -0,0 +1,191 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidCode is returned when a card code cannot be parsed.
+var ErrInvalidCode = errors.New("invalid card code")
+
+// ErrEmptyCode is returned when a card code is empty.
+var ErrEmptyCode = errors.New("empty card code")
+
+// ErrUnknownValue is returned when the value of a card code is not a FrenchCard value.
+var ErrUnknownValue = errors.New("unknown card value")
+
+// ErrUnknownSuit is returned when the suit of a card code is not a FrenchCard suit.
+var ErrUnknownSuit = errors.New("unknown card suit")
+
+// CodeError is the error returned when Token cannot be parsed as a card code, Err being the
+// reason why: ErrEmptyCode, ErrUnknownValue or ErrUnknownSuit.
+// CodeError matches ErrInvalidCode.
+type CodeError struct {
+	Token string
+	Err   error
+}
+
+// Error returns the description of the error.
+func (err *CodeError) Error() string {
+	return fmt.Sprintf("%s '%s': %s", ErrInvalidCode.Error(), err.Token, err.Err.Error())
+}
+
+// Unwrap returns the reason why the card code cannot be parsed.
+func (err *CodeError) Unwrap() error {
+	return err.Err
+}
+
+// Is returns true if target is ErrInvalidCode.
+func (err *CodeError) Is(target error) bool {
+	return target == ErrInvalidCode
+}
+
+// CodeErrors is the error returned when several card codes cannot be parsed, holding a CodeError
+// per invalid card code.
+// CodeErrors matches ErrInvalidCode.
+type CodeErrors []*CodeError
+
+// Error returns the description of the error.
+func (errs CodeErrors) Error() string {
+	descriptions := make([]string, 0, len(errs))
+	for _, err := range errs {
+		descriptions = append(descriptions, err.Error())
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// Is returns true if target is ErrInvalidCode.
+func (errs CodeErrors) Is(target error) bool {
+	return target == ErrInvalidCode
+}
+
+// frenchValueAliases associates the accepted spellings of the FrenchCard values with the values.
+var frenchValueAliases = map[string]string{
+	"A": "ACE", "ACE": "ACE",
+	"2": "2", "TWO": "2",
+	"3": "3", "THREE": "3",
+	"4": "4", "FOUR": "4",
+	"5": "5", "FIVE": "5",
+	"6": "6", "SIX": "6",
+	"7": "7", "SEVEN": "7",
+	"8": "8", "EIGHT": "8",
+	"9": "9", "NINE": "9",
+	"10": "10", "T": "10", "0": "10", "TEN": "10",
+	"J": "JACK", "JACK": "JACK",
+	"Q": "QUEEN", "QUEEN": "QUEEN",
+	"K": "KING", "KING": "KING",
+}
+
+// frenchSuitAliases associates the accepted spellings of the FrenchCard suits, including their
+// Unicode symbols, with the suits.
+var frenchSuitAliases = map[string]FrenchCardSuit{
+	"S": Spades, "SPADE": Spades, "SPADES": Spades, "♠": Spades, "♤": Spades,
+	"D": Diamonds, "DIAMOND": Diamonds, "DIAMONDS": Diamonds, "♦": Diamonds, "♢": Diamonds,
+	"C": Clubs, "CLUB": Clubs, "CLUBS": Clubs, "♣": Clubs, "♧": Clubs,
+	"H": Hearts, "HEART": Hearts, "HEARTS": Hearts, "♥": Hearts, "♡": Hearts,
+}
+
+// frenchJokerAliases associates the accepted spellings of the FrenchCard joker colors with the
+// colors.
+var frenchJokerAliases = map[string]FrenchJokerColor{
+	"R": RedJoker, "RED": RedJoker,
+	"B": BlackJoker, "BLACK": BlackJoker,
+}
+
+// ParseCode parses token as the code of a FrenchCard and returns its canonical code, as computed
+// by ComputeCode.
+// token is case-insensitive and is either a code e.g. "AS", "10H", "TH" or "q♦", a long name e.g.
+// "ace of spades" or "ten of hearts", or a joker e.g. "JR" or "red joker".
+// ParseCode fails with a CodeError if token cannot be parsed.
+func ParseCode(token string) (string, error) {
+	words := strings.Fields(strings.ToUpper(token))
+	var value string
+	var suit string
+	var err error
+	switch {
+	case len(words) == 0:
+		err = ErrEmptyCode
+	case len(words) == 3 && words[1] == "OF":
+		value, suit, err = parseFrenchCardName(words[0], words[2])
+	case len(words) == 2 && words[1] == FrenchJokerValue:
+		value, suit, err = parseFrenchJoker(words[0])
+	case len(words) == 1:
+		value, suit, err = parseFrenchCardCode(words[0])
+	default:
+		err = ErrUnknownValue
+	}
+	if err != nil {
+		return "", &CodeError{Token: token, Err: err}
+	}
+	card, err := NewPlayingCard(suit, value)
+	if err != nil {
+		return "", &CodeError{Token: token, Err: err}
+	}
+	return card.Code, nil
+}
+
+// ParseCodes parses every token of tokens with ParseCode and returns the canonical codes, in the
+// same order. The blank tokens are skipped.
+// ParseCodes fails with CodeErrors, holding a CodeError per invalid token, if any of the tokens
+// cannot be parsed.
+func ParseCodes(tokens []string) ([]string, error) {
+	codes := make([]string, 0, len(tokens))
+	var errs CodeErrors
+	for _, token := range tokens {
+		if strings.TrimSpace(token) == "" {
+			continue
+		}
+		code, err := ParseCode(token)
+		if err != nil {
+			errs = append(errs, err.(*CodeError))
+			continue
+		}
+		codes = append(codes, code)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return codes, nil
+}
+
+// parseFrenchCardCode returns the value and the suit of the FrenchCard whose code is code, the
+// suit being its last character.
+func parseFrenchCardCode(code string) (value string, suit string, err error) {
+	suitSymbol, size := utf8.DecodeLastRuneInString(code)
+	valueSymbol := code[:len(code)-size]
+	if valueSymbol == "" {
+		return "", "", ErrUnknownValue
+	}
+	if valueSymbol == "J" || valueSymbol == FrenchJokerValue {
+		if color, isPresent := frenchJokerAliases[string(suitSymbol)]; isPresent {
+			return FrenchJokerValue, color.String(), nil
+		}
+	}
+	return parseFrenchCardName(valueSymbol, string(suitSymbol))
+}
+
+// parseFrenchCardName returns the value and the suit of the FrenchCard whose value is spelled
+// valueName and whose suit is spelled suitName.
+func parseFrenchCardName(valueName string, suitName string) (value string, suit string, err error) {
+	value, isPresent := frenchValueAliases[valueName]
+	if !isPresent {
+		return "", "", ErrUnknownValue
+	}
+	frenchSuit, isPresent := frenchSuitAliases[suitName]
+	if !isPresent {
+		return "", "", ErrUnknownSuit
+	}
+	return value, frenchSuit.String(), nil
+}
+
+// parseFrenchJoker returns the value and the suit of the FrenchCard joker whose color is spelled
+// colorName.
+func parseFrenchJoker(colorName string) (value string, suit string, err error) {
+	color, isPresent := frenchJokerAliases[colorName]
+	if !isPresent {
+		return "", "", ErrUnknownSuit
+	}
+	return FrenchJokerValue, color.String(), nil
+}
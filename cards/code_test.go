@@ -0,0 +1,85 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCode(t *testing.T) {
+	testRecords := []struct {
+		token        string
+		expectedCode string
+	}{
+		{"AS", "AS"},
+		{"as", "AS"},
+		{" 10h ", "10H"},
+		{"TH", "10H"},
+		{"0h", "10H"},
+		{"qD", "QD"},
+		{"A♠", "AS"},
+		{"K♥", "KH"},
+		{"j♧", "JC"},
+		{"10♢", "10D"},
+		{"ace of spades", "AS"},
+		{"Ten  of Hearts", "10H"},
+		{"7 of clubs", "7C"},
+		{"queen of diamond", "QD"},
+		{"JR", "JR"},
+		{"jb", "JB"},
+		{"red joker", "JR"},
+		{"BLACK JOKER", "JB"},
+	}
+	for _, testRecord := range testRecords {
+		code, err := ParseCode(testRecord.token)
+		assert.Nil(t, err, "expected no error for '%s'", testRecord.token)
+		assert.Equal(t, testRecord.expectedCode, code, "expected the canonical code of '%s'", testRecord.token)
+	}
+}
+
+func TestParseCodeFailure(t *testing.T) {
+	testRecords := []struct {
+		token         string
+		expectedError error
+	}{
+		{"", ErrEmptyCode},
+		{"   ", ErrEmptyCode},
+		{"S", ErrUnknownValue},
+		{"1S", ErrUnknownValue},
+		{"11H", ErrUnknownValue},
+		{"♠", ErrUnknownValue},
+		{"KX", ErrUnknownSuit},
+		{"JX", ErrUnknownSuit},
+		{"ace of swords", ErrUnknownSuit},
+		{"eleven of hearts", ErrUnknownValue},
+		{"green joker", ErrUnknownSuit},
+		{"ace spades", ErrUnknownValue},
+	}
+	for _, testRecord := range testRecords {
+		code, err := ParseCode(testRecord.token)
+		assert.Empty(t, code, "expected no code for '%s'", testRecord.token)
+		assert.ErrorIs(t, err, ErrInvalidCode, "expected an invalid code error for '%s'", testRecord.token)
+		assert.ErrorIs(t, err, testRecord.expectedError, "expected the reason of the error for '%s'", testRecord.token)
+		var codeError *CodeError
+		if assert.ErrorAs(t, err, &codeError) {
+			assert.Equal(t, testRecord.token, codeError.Token, "expected the invalid token")
+		}
+	}
+}
+
+func TestParseCodes(t *testing.T) {
+	codes, err := ParseCodes([]string{"as", "", " ", "T♥", "king of clubs"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []string{"AS", "10H", "KC"}, codes, "expected the canonical codes without the blank tokens")
+
+	codes, err = ParseCodes([]string{"AS", "XS", "KH", "KZ"})
+	assert.Nil(t, codes, "expected no codes")
+	assert.ErrorIs(t, err, ErrInvalidCode)
+	var codeErrors CodeErrors
+	if assert.ErrorAs(t, err, &codeErrors) {
+		assert.Len(t, codeErrors, 2, "expected an error per invalid token")
+		assert.Equal(t, "XS", codeErrors[0].Token)
+		assert.ErrorIs(t, codeErrors[0], ErrUnknownValue)
+		assert.Equal(t, "KZ", codeErrors[1].Token)
+		assert.ErrorIs(t, codeErrors[1], ErrUnknownSuit)
+	}
+}
@@ -1,5 +1,10 @@
 package cards
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // FrenchCard is the representation of a French-suited playable card.
 type FrenchCard struct {
 	PlayingCard
@@ -43,12 +48,178 @@ var FrenchCardValues = [13]string{
 	"QUEEN",
 	"KING"}
 
+// FrenchCardColor is the representation of the color of a FrenchCard.
+type FrenchCardColor string
+
+const (
+	Red   FrenchCardColor = "RED"
+	Black FrenchCardColor = "BLACK"
+)
+
+// String returns a stringified version of a FrenchCardColor.
+func (color FrenchCardColor) String() string {
+	return string(color)
+}
+
+// FrenchJokerValue is the value of the FrenchCard jokers.
+const FrenchJokerValue = "JOKER"
+
+// FrenchJokerColor is the representation of a FrenchCard joker color.
+// The color of a joker stands for its suit, so that the code of a joker never collides with the
+// code of a suited FrenchCard e.g. "JR" for the red joker.
+type FrenchJokerColor string
+
+const (
+	RedJoker   FrenchJokerColor = "RED"
+	BlackJoker FrenchJokerColor = "BLACK"
+)
+
+// String returns a stringified version of a FrenchJokerColor.
+func (color FrenchJokerColor) String() string {
+	return string(color)
+}
+
+// FrenchJokerColors is the definition of the FrenchCard jokers panel.
+// FrenchJokerColors must not be modified to preserve the French-suited playing card standards.
+var FrenchJokerColors = [2]FrenchJokerColor{RedJoker, BlackJoker}
+
+// FrenchCardPoints is the representation of a point scheme of FrenchCard, associating a card
+// value e.g. "KING", or a card code e.g. "QS", with the number of points the cards are worth.
+// The points of a card code take precedence over the points of its value, and the cards missing
+// from a FrenchCardPoints are worth their default points.
+type FrenchCardPoints map[string]int
+
+// Validate ensures every key of a FrenchCardPoints is a FrenchCard value or code.
+// A successful Validate returns err == nil.
+func (points FrenchCardPoints) Validate() error {
+	for key := range points {
+		if frenchValueRank(key) == 0 && key != FrenchJokerValue && !isFrenchCardCode(key) {
+			return fmt.Errorf("unknown french card value or code '%s'", key)
+		}
+	}
+	return nil
+}
+
 // NewFrenchCard creates and returns a FrenchCard based on the provided suit and value.
+// The metadata of a FrenchCard holds its rank, color, face flag and default points.
 // A successful NewFrenchCard returns err == nil.
 func NewFrenchCard(suit string, value string) (*FrenchCard, error) {
 	playingCard, err := NewPlayingCard(suit, value)
 	if err != nil {
 		return nil, err
 	}
-	return &FrenchCard{PlayingCard: *playingCard}, nil
+	card := FrenchCard{PlayingCard: *playingCard}
+	card.Metadata = &CardMetadata{
+		Rank:   frenchValueRank(value),
+		Color:  frenchSuitColor(suit).String(),
+		Face:   card.IsFace(),
+		Points: defaultFrenchCardPoints(value),
+	}
+	return &card, nil
+}
+
+// NewFrenchJoker creates and returns a FrenchCard joker of the provided color.
+// A successful NewFrenchJoker returns err == nil.
+func NewFrenchJoker(color string) (*FrenchCard, error) {
+	return NewFrenchCard(color, FrenchJokerValue)
+}
+
+// IsJoker returns true if the FrenchCard is a joker.
+func (card FrenchCard) IsJoker() bool {
+	return card.Value == FrenchJokerValue
+}
+
+// Rank returns the ordinal of the value of the FrenchCard among FrenchCardValues, from 1 for the
+// Ace to 13 for the King, or zero for a joker.
+func (card FrenchCard) Rank() int {
+	return frenchValueRank(card.Value)
+}
+
+// Color returns the FrenchCardColor of the FrenchCard: Red for diamonds, hearts and the red joker,
+// Black otherwise.
+func (card FrenchCard) Color() FrenchCardColor {
+	return frenchSuitColor(card.Suit)
+}
+
+// IsFace returns true if the FrenchCard is a Jack, a Queen or a King.
+func (card FrenchCard) IsFace() bool {
+	return card.Value == "JACK" || card.Value == "QUEEN" || card.Value == "KING"
+}
+
+// Points returns the number of points the FrenchCard is worth, according to its metadata, or its
+// default points if it has none.
+func (card FrenchCard) Points() int {
+	if card.Metadata == nil {
+		return defaultFrenchCardPoints(card.Value)
+	}
+	return card.Metadata.Points
+}
+
+// ApplyPoints sets the points of the FrenchCard according to points.
+// ApplyPoints replaces the metadata of the FrenchCard rather than modifying it, as metadata can be
+// shared by the copies of a card.
+func (card *FrenchCard) ApplyPoints(points FrenchCardPoints) {
+	cardPoints, isPresent := points[card.Code]
+	if !isPresent {
+		cardPoints, isPresent = points[card.Value]
+	}
+	if !isPresent || card.Metadata == nil {
+		return
+	}
+	metadata := *card.Metadata
+	metadata.Points = cardPoints
+	card.Metadata = &metadata
+}
+
+// frenchValueRank returns the 1-based index of value in FrenchCardValues, or zero if value is not
+// a FrenchCard value.
+func frenchValueRank(value string) int {
+	for i, frenchValue := range FrenchCardValues {
+		if frenchValue == value {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// frenchSuitColor returns the FrenchCardColor of the cards of suit, a joker color standing for its
+// suit.
+func frenchSuitColor(suit string) FrenchCardColor {
+	switch suit {
+	case Diamonds.String(), Hearts.String(), RedJoker.String():
+		return Red
+	}
+	return Black
+}
+
+// defaultFrenchCardPoints returns the default points of the cards of value: the number of a
+// numbered card, 1 for the Ace, 10 for a face card, and 0 for a joker.
+func defaultFrenchCardPoints(value string) int {
+	switch value {
+	case "ACE":
+		return 1
+	case "JACK", "QUEEN", "KING":
+		return 10
+	case FrenchJokerValue:
+		return 0
+	}
+	points, _ := strconv.Atoi(value)
+	return points
+}
+
+// isFrenchCardCode returns true if code is the code of a suited FrenchCard or of a joker.
+func isFrenchCardCode(code string) bool {
+	for _, suit := range FrenchCardSuits {
+		for _, value := range FrenchCardValues {
+			if card, _ := NewPlayingCard(suit.String(), value); card.Code == code {
+				return true
+			}
+		}
+	}
+	for _, color := range FrenchJokerColors {
+		if card, _ := NewPlayingCard(color.String(), FrenchJokerValue); card.Code == code {
+			return true
+		}
+	}
+	return false
 }
@@ -66,3 +66,98 @@ func TestFrenchCardWithEmptyValue(t *testing.T) {
 	assert.Nil(t, card)
 	assert.NotNil(t, err)
 }
+
+func TestFrenchJoker(t *testing.T) {
+	testRecords := []struct {
+		color        FrenchJokerColor
+		expectedCode string
+	}{
+		{RedJoker, "JR"},
+		{BlackJoker, "JB"},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewFrenchJoker(testRecord.color.String())
+		assert.Nil(t, err)
+		assert.Equal(t, testRecord.expectedCode, card.Code)
+		assert.True(t, card.IsJoker(), "expected a joker")
+	}
+}
+
+func TestFrenchJokerCodesDoNotCollide(t *testing.T) {
+	codes := make(map[string]bool)
+	for _, suit := range FrenchCardSuits {
+		for _, value := range FrenchCardValues {
+			card, _ := NewFrenchCard(suit.String(), value)
+			assert.False(t, card.IsJoker(), "expected a suited card")
+			codes[card.Code] = true
+		}
+	}
+	for _, color := range FrenchJokerColors {
+		card, _ := NewFrenchJoker(color.String())
+		assert.False(t, codes[card.Code], "expected the joker code '%s' to be unique", card.Code)
+		codes[card.Code] = true
+	}
+}
+
+func TestFrenchCardMetadata(t *testing.T) {
+	testRecords := []struct {
+		suit             string
+		value            string
+		expectedMetadata CardMetadata
+	}{
+		{Spades.String(), "ACE", CardMetadata{Rank: 1, Color: "BLACK", Face: false, Points: 1}},
+		{Hearts.String(), "7", CardMetadata{Rank: 7, Color: "RED", Face: false, Points: 7}},
+		{Diamonds.String(), "10", CardMetadata{Rank: 10, Color: "RED", Face: false, Points: 10}},
+		{Clubs.String(), "JACK", CardMetadata{Rank: 11, Color: "BLACK", Face: true, Points: 10}},
+		{Hearts.String(), "KING", CardMetadata{Rank: 13, Color: "RED", Face: true, Points: 10}},
+		{RedJoker.String(), FrenchJokerValue, CardMetadata{Rank: 0, Color: "RED", Face: false, Points: 0}},
+		{BlackJoker.String(), FrenchJokerValue, CardMetadata{Rank: 0, Color: "BLACK", Face: false, Points: 0}},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewFrenchCard(testRecord.suit, testRecord.value)
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, &testRecord.expectedMetadata, card.Metadata, "expected the metadata of the %s of %s", testRecord.value, testRecord.suit)
+		assert.Equal(t, testRecord.expectedMetadata.Rank, card.Rank())
+		assert.Equal(t, testRecord.expectedMetadata.Color, card.Color().String())
+		assert.Equal(t, testRecord.expectedMetadata.Face, card.IsFace())
+		assert.Equal(t, testRecord.expectedMetadata.Points, card.Points())
+	}
+}
+
+func TestFrenchCardApplyPoints(t *testing.T) {
+	points := FrenchCardPoints{"ACE": 11, "QUEEN": 3, "QS": 13}
+
+	queenOfSpades, _ := NewFrenchCard(Spades.String(), "QUEEN")
+	sharedMetadata := queenOfSpades.Metadata
+	queenOfSpades.ApplyPoints(points)
+	assert.Equal(t, 13, queenOfSpades.Points(), "expected the points of the code to take precedence")
+	assert.Equal(t, 10, sharedMetadata.Points, "expected the shared metadata to be untouched")
+
+	queenOfHearts, _ := NewFrenchCard(Hearts.String(), "QUEEN")
+	queenOfHearts.ApplyPoints(points)
+	assert.Equal(t, 3, queenOfHearts.Points(), "expected the points of the value")
+
+	twoOfHearts, _ := NewFrenchCard(Hearts.String(), "2")
+	twoOfHearts.ApplyPoints(points)
+	assert.Equal(t, 2, twoOfHearts.Points(), "expected the default points")
+}
+
+func TestFrenchCardPointsValidate(t *testing.T) {
+	testRecords := []struct {
+		points        FrenchCardPoints
+		expectedValid bool
+	}{
+		{FrenchCardPoints{}, true},
+		{FrenchCardPoints{"ACE": 11, "10": 10, "KH": 4, "JR": 50, FrenchJokerValue: 25}, true},
+		{FrenchCardPoints{"KNIGHT": 3}, false},
+		{FrenchCardPoints{"ZZ": 1}, false},
+	}
+	for _, testRecord := range testRecords {
+		err := testRecord.points.Validate()
+		if testRecord.expectedValid {
+			assert.Nil(t, err, "expected valid points")
+		} else {
+			assert.NotNil(t, err, "expected an error")
+		}
+	}
+}
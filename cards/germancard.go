@@ -0,0 +1,54 @@
+package cards
+
+// GermanCard is the representation of a German-suited playable card.
+type GermanCard struct {
+	PlayingCard
+}
+
+var _ Card = &GermanCard{}
+
+// GermanCardSuit is the representation of a GermanCard suit.
+type GermanCardSuit string
+
+const (
+	Eichel   GermanCardSuit = "EICHEL"
+	Gruen    GermanCardSuit = "GRUEN"
+	Herz     GermanCardSuit = "HERZ"
+	Schellen GermanCardSuit = "SCHELLEN"
+)
+
+// String returns a stringified version of a GermanCardSuit.
+func (suit GermanCardSuit) String() string {
+	return string(suit)
+}
+
+// GermanCardSuits is the definition of the GermanCard suits panel.
+// GermanCardSuits must not be modified to preserve the German-suited playing card standards.
+var GermanCardSuits = [4]GermanCardSuit{Eichel, Gruen, Herz, Schellen}
+
+// GermanCardValues is the definition of the GermanCard values panel of a 36-card deck.
+// The 32-card deck, used to play Skat, leaves out the 6.
+// GermanCardValues must not be modified to preserve the German-suited playing card standards.
+var GermanCardValues = [9]string{
+	"ASS",
+	"6",
+	"7",
+	"8",
+	"9",
+	"10",
+	"UNTER",
+	"OBER",
+	"KOENIG"}
+
+// NewGermanCard creates and returns a GermanCard based on the provided suit and value.
+// The code of a GermanCard is made of its value, or the initial of its value if alphabetical,
+// followed by the initial of its suit e.g. "AE" for the Eichel-Ass or "UG" for the Grün-Unter, so
+// that every code is unique in a German deck.
+// A successful NewGermanCard returns err == nil.
+func NewGermanCard(suit string, value string) (*GermanCard, error) {
+	playingCard, err := NewPlayingCard(suit, value)
+	if err != nil {
+		return nil, err
+	}
+	return &GermanCard{PlayingCard: *playingCard}, nil
+}
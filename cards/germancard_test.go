@@ -0,0 +1,46 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGermanCardStringifiedSuit(t *testing.T) {
+	testRecords := []struct {
+		suit                    GermanCardSuit
+		expectedStringifiedSuit string
+	}{
+		{Eichel, "EICHEL"},
+		{Gruen, "GRUEN"},
+		{Herz, "HERZ"},
+		{Schellen, "SCHELLEN"},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectedStringifiedSuit, testRecord.suit.String(), "expected identical enum representation")
+	}
+}
+
+func TestGermanCardSuits(t *testing.T) {
+	expectedSuits := [4]GermanCardSuit{Eichel, Gruen, Herz, Schellen}
+	assert.Equal(t, expectedSuits, GermanCardSuits)
+}
+
+func TestGermanCardValues(t *testing.T) {
+	expectedValues := [9]string{"ASS", "6", "7", "8", "9", "10", "UNTER", "OBER", "KOENIG"}
+	assert.Equal(t, expectedValues, GermanCardValues)
+}
+
+func TestGermanCardCodesAreUnique(t *testing.T) {
+	codes := make(map[string]bool)
+	for _, suit := range GermanCardSuits {
+		for _, value := range GermanCardValues {
+			card, err := NewGermanCard(suit.String(), value)
+			assert.Nil(t, err, "expected no error")
+			assert.False(t, codes[card.Code], "expected a unique code; got '%s' twice", card.Code)
+			codes[card.Code] = true
+		}
+	}
+	assert.True(t, codes["AE"], "expected the Eichel-Ass to be coded 'AE'")
+	assert.True(t, codes["UG"], "expected the Grün-Unter to be coded 'UG'")
+	assert.True(t, codes["10S"], "expected the Schellen-Zehn to be coded '10S'")
+}
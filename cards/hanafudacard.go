@@ -0,0 +1,158 @@
+package cards
+
+import (
+	"errors"
+	"strconv"
+)
+
+// HanafudaCard is the representation of a Hanafuda playable card.
+// The suit of a HanafudaCard is the flower of its month, and its value is the name of the card
+// e.g. "CRANE". The category of a HanafudaCard is provided by its attributes.
+type HanafudaCard struct {
+	PlayingCard
+}
+
+var _ Card = &HanafudaCard{}
+
+// HanafudaMonth is the representation of a HanafudaCard month, named after its flower, which
+// stands for its suit.
+type HanafudaMonth string
+
+const (
+	Pine          HanafudaMonth = "PINE"
+	Plum          HanafudaMonth = "PLUM"
+	Cherry        HanafudaMonth = "CHERRY"
+	Wisteria      HanafudaMonth = "WISTERIA"
+	Iris          HanafudaMonth = "IRIS"
+	Peony         HanafudaMonth = "PEONY"
+	BushClover    HanafudaMonth = "BUSH_CLOVER"
+	Pampas        HanafudaMonth = "PAMPAS"
+	Chrysanthemum HanafudaMonth = "CHRYSANTHEMUM"
+	Maple         HanafudaMonth = "MAPLE"
+	Willow        HanafudaMonth = "WILLOW"
+	Paulownia     HanafudaMonth = "PAULOWNIA"
+)
+
+// String returns a stringified version of a HanafudaMonth.
+func (month HanafudaMonth) String() string {
+	return string(month)
+}
+
+// Number returns the 1-based number of a HanafudaMonth, or zero if the month is unknown.
+func (month HanafudaMonth) Number() int {
+	for i, hanafudaMonth := range HanafudaMonths {
+		if hanafudaMonth == month {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// HanafudaMonths is the definition of the HanafudaCard months panel, from January to December.
+// HanafudaMonths must not be modified to preserve the Hanafuda playing card standards.
+var HanafudaMonths = [12]HanafudaMonth{Pine, Plum, Cherry, Wisteria, Iris, Peony, BushClover, Pampas, Chrysanthemum, Maple, Willow, Paulownia}
+
+// HanafudaCategory is the representation of a HanafudaCard category, which determines its score.
+type HanafudaCategory string
+
+const (
+	Bright HanafudaCategory = "BRIGHT"
+	Animal HanafudaCategory = "ANIMAL"
+	Ribbon HanafudaCategory = "RIBBON"
+	Chaff  HanafudaCategory = "CHAFF"
+)
+
+// String returns a stringified version of a HanafudaCategory.
+func (category HanafudaCategory) String() string {
+	return string(category)
+}
+
+// HanafudaCategoryAttribute is the key of the attribute holding the category of a HanafudaCard.
+const HanafudaCategoryAttribute = "category"
+
+// HanafudaMonthCards is the definition of the names of the 4 cards of every month of
+// HanafudaMonths, the chaff cards of a same month being copies of a same card.
+// HanafudaMonthCards must not be modified to preserve the Hanafuda playing card standards.
+var HanafudaMonthCards = [12][4]string{
+	{"CRANE", "POETRY_RIBBON", "CHAFF", "CHAFF"},
+	{"WARBLER", "POETRY_RIBBON", "CHAFF", "CHAFF"},
+	{"CURTAIN", "POETRY_RIBBON", "CHAFF", "CHAFF"},
+	{"CUCKOO", "RED_RIBBON", "CHAFF", "CHAFF"},
+	{"BRIDGE", "RED_RIBBON", "CHAFF", "CHAFF"},
+	{"BUTTERFLIES", "BLUE_RIBBON", "CHAFF", "CHAFF"},
+	{"BOAR", "RED_RIBBON", "CHAFF", "CHAFF"},
+	{"MOON", "GEESE", "CHAFF", "CHAFF"},
+	{"SAKE_CUP", "BLUE_RIBBON", "CHAFF", "CHAFF"},
+	{"DEER", "BLUE_RIBBON", "CHAFF", "CHAFF"},
+	{"RAIN_MAN", "SWALLOW", "RED_RIBBON", "LIGHTNING"},
+	{"PHOENIX", "CHAFF", "CHAFF", "CHAFF"}}
+
+// hanafudaCardCategories is the definition of the HanafudaCategory of every card name of
+// HanafudaMonthCards.
+var hanafudaCardCategories = map[string]HanafudaCategory{
+	"CRANE":         Bright,
+	"CURTAIN":       Bright,
+	"MOON":          Bright,
+	"RAIN_MAN":      Bright,
+	"PHOENIX":       Bright,
+	"WARBLER":       Animal,
+	"CUCKOO":        Animal,
+	"BRIDGE":        Animal,
+	"BUTTERFLIES":   Animal,
+	"BOAR":          Animal,
+	"GEESE":         Animal,
+	"SAKE_CUP":      Animal,
+	"DEER":          Animal,
+	"SWALLOW":       Animal,
+	"POETRY_RIBBON": Ribbon,
+	"RED_RIBBON":    Ribbon,
+	"BLUE_RIBBON":   Ribbon,
+	"CHAFF":         Chaff,
+	"LIGHTNING":     Chaff,
+}
+
+// hanafudaCategoryAttributes holds the attributes of every HanafudaCategory, shared by the cards
+// of the category.
+var hanafudaCategoryAttributes = map[HanafudaCategory]map[string]string{
+	Bright: {HanafudaCategoryAttribute: Bright.String()},
+	Animal: {HanafudaCategoryAttribute: Animal.String()},
+	Ribbon: {HanafudaCategoryAttribute: Ribbon.String()},
+	Chaff:  {HanafudaCategoryAttribute: Chaff.String()},
+}
+
+// NewHanafudaCard creates and returns the HanafudaCard of the provided month and name.
+// The code of a HanafudaCard is made of the number of its month followed by the initial of its
+// category e.g. "1B" for the Crane of Pine or "11C" for the Lightning of Willow.
+// NewHanafudaCard fails if the month has no card of that name.
+// A successful NewHanafudaCard returns err == nil.
+func NewHanafudaCard(month string, name string) (*HanafudaCard, error) {
+	monthNumber := HanafudaMonth(month).Number()
+	if monthNumber == 0 {
+		return nil, errors.New("unknown hanafuda month")
+	}
+	for _, monthCardName := range HanafudaMonthCards[monthNumber-1] {
+		if monthCardName == name {
+			category := hanafudaCardCategories[name]
+			card := HanafudaCard{PlayingCard: PlayingCard{Suit: month, Value: name, Attributes: hanafudaCategoryAttributes[category]}}
+			card.Code = card.ComputeCode()
+			return &card, nil
+		}
+	}
+	return nil, errors.New("unknown hanafuda card")
+}
+
+// ComputeCode determines and returns the code of a HanafudaCard.
+// The copies of a same chaff card share their code.
+func (card HanafudaCard) ComputeCode() string {
+	return strconv.Itoa(card.Month().Number()) + card.Category().String()[0:1]
+}
+
+// Category returns the HanafudaCategory of the HanafudaCard.
+func (card HanafudaCard) Category() HanafudaCategory {
+	return hanafudaCardCategories[card.Value]
+}
+
+// Month returns the HanafudaMonth of the HanafudaCard.
+func (card HanafudaCard) Month() HanafudaMonth {
+	return HanafudaMonth(card.Suit)
+}
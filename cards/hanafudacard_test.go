@@ -0,0 +1,64 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHanafudaMonthNumber(t *testing.T) {
+	testRecords := []struct {
+		month          HanafudaMonth
+		expectedNumber int
+	}{
+		{Pine, 1},
+		{Iris, 5},
+		{Willow, 11},
+		{Paulownia, 12},
+		{"LOTUS", 0},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectedNumber, testRecord.month.Number(), "expected the number of %s", testRecord.month)
+	}
+}
+
+func TestHanafudaMonthCards(t *testing.T) {
+	categoryOccurrences := make(map[HanafudaCategory]int)
+	for _, monthCards := range HanafudaMonthCards {
+		for _, name := range monthCards {
+			category, isPresent := hanafudaCardCategories[name]
+			assert.True(t, isPresent, "expected a category for '%s'", name)
+			categoryOccurrences[category] += 1
+		}
+	}
+	assert.Equal(t, map[HanafudaCategory]int{Bright: 5, Animal: 9, Ribbon: 10, Chaff: 24}, categoryOccurrences)
+}
+
+func TestNewHanafudaCard(t *testing.T) {
+	testRecords := []struct {
+		month            string
+		name             string
+		expectedCode     string
+		expectedCategory HanafudaCategory
+	}{
+		{Pine.String(), "CRANE", "1B", Bright},
+		{Plum.String(), "WARBLER", "2A", Animal},
+		{Peony.String(), "BLUE_RIBBON", "6R", Ribbon},
+		{Willow.String(), "LIGHTNING", "11C", Chaff},
+		{Paulownia.String(), "CHAFF", "12C", Chaff},
+		{Pine.String(), "MOON", "", ""},
+		{"LOTUS", "CHAFF", "", ""},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewHanafudaCard(testRecord.month, testRecord.name)
+		if testRecord.expectedCode == "" {
+			assert.Nil(t, card, "expected no card")
+			assert.NotNil(t, err, "expected an error")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, testRecord.expectedCode, card.Code, "expected the Hanafuda card code")
+		assert.Equal(t, testRecord.expectedCategory, card.Category())
+		assert.Equal(t, HanafudaMonth(testRecord.month), card.Month())
+		assert.Equal(t, testRecord.expectedCategory.String(), card.Attributes[HanafudaCategoryAttribute], "expected the category attribute")
+	}
+}
@@ -0,0 +1,55 @@
+package cards
+
+// ItalianCard is the representation of an Italian-suited playable card.
+type ItalianCard struct {
+	PlayingCard
+}
+
+var _ Card = &ItalianCard{}
+
+// ItalianCardSuit is the representation of an ItalianCard suit.
+type ItalianCardSuit string
+
+const (
+	Denari  ItalianCardSuit = "DENARI"
+	Coppe   ItalianCardSuit = "COPPE"
+	Spade   ItalianCardSuit = "SPADE"
+	Bastoni ItalianCardSuit = "BASTONI"
+)
+
+// String returns a stringified version of an ItalianCardSuit.
+func (suit ItalianCardSuit) String() string {
+	return string(suit)
+}
+
+// ItalianCardSuits is the definition of the ItalianCard suits panel.
+// ItalianCardSuits must not be modified to preserve the Italian-suited playing card standards.
+var ItalianCardSuits = [4]ItalianCardSuit{Denari, Coppe, Spade, Bastoni}
+
+// ItalianCardValues is the definition of the ItalianCard values panel of a 40-card deck, as found
+// in the Napoletane cards.
+// ItalianCardValues must not be modified to preserve the Italian-suited playing card standards.
+var ItalianCardValues = [10]string{
+	"ASSO",
+	"2",
+	"3",
+	"4",
+	"5",
+	"6",
+	"7",
+	"FANTE",
+	"CAVALLO",
+	"RE"}
+
+// NewItalianCard creates and returns an ItalianCard based on the provided suit and value.
+// The code of an ItalianCard is made of its value, or the initial of its value if alphabetical,
+// followed by the initial of its suit e.g. "AD" for the Asso di Denari or "CS" for the Cavallo di
+// Spade, so that every code is unique in an Italian deck.
+// A successful NewItalianCard returns err == nil.
+func NewItalianCard(suit string, value string) (*ItalianCard, error) {
+	playingCard, err := NewPlayingCard(suit, value)
+	if err != nil {
+		return nil, err
+	}
+	return &ItalianCard{PlayingCard: *playingCard}, nil
+}
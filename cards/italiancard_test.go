@@ -0,0 +1,46 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestItalianCardStringifiedSuit(t *testing.T) {
+	testRecords := []struct {
+		suit                    ItalianCardSuit
+		expectedStringifiedSuit string
+	}{
+		{Denari, "DENARI"},
+		{Coppe, "COPPE"},
+		{Spade, "SPADE"},
+		{Bastoni, "BASTONI"},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectedStringifiedSuit, testRecord.suit.String(), "expected identical enum representation")
+	}
+}
+
+func TestItalianCardSuits(t *testing.T) {
+	expectedSuits := [4]ItalianCardSuit{Denari, Coppe, Spade, Bastoni}
+	assert.Equal(t, expectedSuits, ItalianCardSuits)
+}
+
+func TestItalianCardValues(t *testing.T) {
+	expectedValues := [10]string{"ASSO", "2", "3", "4", "5", "6", "7", "FANTE", "CAVALLO", "RE"}
+	assert.Equal(t, expectedValues, ItalianCardValues)
+}
+
+func TestItalianCardCodesAreUnique(t *testing.T) {
+	codes := make(map[string]bool)
+	for _, suit := range ItalianCardSuits {
+		for _, value := range ItalianCardValues {
+			card, err := NewItalianCard(suit.String(), value)
+			assert.Nil(t, err, "expected no error")
+			assert.False(t, codes[card.Code], "expected a unique code; got '%s' twice", card.Code)
+			codes[card.Code] = true
+		}
+	}
+	assert.True(t, codes["AD"], "expected the Asso di Denari to be coded 'AD'")
+	assert.True(t, codes["CC"], "expected the Cavallo di Coppe to be coded 'CC'")
+	assert.True(t, codes["RB"], "expected the Re di Bastoni to be coded 'RB'")
+}
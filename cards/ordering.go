@@ -0,0 +1,234 @@
+package cards
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidOrdering is returned when a card ordering cannot be parsed.
+var ErrInvalidOrdering = errors.New("invalid ordering")
+
+// ErrUnsupportedOrdering is returned when a card ordering is applied to a type of cards it does not
+// rank.
+var ErrUnsupportedOrdering = errors.New("unsupported ordering")
+
+// Comparator is the interface that wraps the methods used to compare cards.
+//
+// Compare returns a negative number if a ranks below b, zero if a and b rank equally, and a
+// positive number if a ranks above b.
+// Supports returns true if Compare ranks the cards of cardType.
+type Comparator interface {
+	Compare(a PlayingCard, b PlayingCard) int
+	Supports(cardType PlayingCardType) bool
+}
+
+// AceHighRanks is the definition of the FrenchCard values from the lowest to the highest, the Ace
+// ranking above the King.
+// AceHighRanks must not be modified.
+var AceHighRanks = [13]string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "JACK", "QUEEN", "KING", "ACE"}
+
+// AceLowRanks is the definition of the FrenchCard values from the lowest to the highest, the Ace
+// ranking below the 2.
+// AceLowRanks must not be modified.
+var AceLowRanks = FrenchCardValues
+
+// SkatRanks is the definition of the values of the suited cards of a Skat game from the lowest to
+// the highest, the Jacks being trumps.
+// SkatRanks must not be modified.
+var SkatRanks = [7]string{"7", "8", "9", "QUEEN", "KING", "10", "ACE"}
+
+// GermanSkatRanks is the definition of the values of the suited GermanCard of a Skat game from the
+// lowest to the highest, the Unters being trumps.
+// GermanSkatRanks must not be modified.
+var GermanSkatRanks = [7]string{"7", "8", "9", "OBER", "KOENIG", "10", "ASS"}
+
+// bridgeSuitOrder is the order of the FrenchCard suits from the lowest to the highest, used to
+// break the ties between cards of the same rank.
+var bridgeSuitOrder = []string{Clubs.String(), Diamonds.String(), Hearts.String(), Spades.String()}
+
+// skatSuitOrder is the order of the FrenchCard suits of a Skat game from the lowest to the highest.
+var skatSuitOrder = []string{Diamonds.String(), Hearts.String(), Spades.String(), Clubs.String()}
+
+// germanSkatSuitOrder is the order of the GermanCard suits of a Skat game from the lowest to the
+// highest.
+var germanSkatSuitOrder = []string{Schellen.String(), Herz.String(), Gruen.String(), Eichel.String()}
+
+// RankOrdering is a Comparator ranking the cards by value according to Ranks, from the lowest to
+// the highest, the cards of the Trump suit, if any, ranking above all the other cards.
+// The cards of the same rank are ordered by suit: clubs, diamonds, hearts then spades.
+// The cards whose value is missing from Ranks, e.g. the jokers, rank below the other cards.
+type RankOrdering struct {
+	Ranks []string
+	Trump string
+}
+
+// SkatOrdering is a Comparator ranking the cards according to the rules of Skat: the Jacks are the
+// highest trumps, ordered by suit: diamonds, hearts, spades then clubs, followed by the cards of
+// the Trump suit, if any, then the other cards. The suited cards are ranked according to SkatRanks.
+// SkatOrdering ranks German-suited cards alike, the Unters standing for the Jacks, ordered by suit:
+// schellen, herz, gruen then eichel, and the suited cards being ranked according to GermanSkatRanks.
+// A game without Trump suit is a Grand, whose only trumps are the Jacks.
+type SkatOrdering struct {
+	Trump string
+}
+
+// EuchreOrdering is a Comparator ranking the cards according to the rules of Euchre: the Jack of the
+// Trump suit, the right bower, is the highest card, followed by the Jack of the suit of the same
+// color, the left bower, which counts as a trump, then by the other cards of the Trump suit and by
+// the other cards, ace high.
+type EuchreOrdering struct {
+	Trump string
+}
+
+var (
+	_ Comparator = RankOrdering{}
+	_ Comparator = SkatOrdering{}
+	_ Comparator = EuchreOrdering{}
+)
+
+// cardStrength is the representation of the strength of a card, compared element by element: its
+// trump level, then its rank, then its suit.
+type cardStrength [3]int
+
+// Compare compares a and b by value according to Ranks, the cards of the Trump suit ranking above
+// the others.
+func (ordering RankOrdering) Compare(a PlayingCard, b PlayingCard) int {
+	return compareStrengths(ordering.strength(a), ordering.strength(b))
+}
+
+// Supports returns true if cardType is French, and if the Trump suit, if any, is a FrenchCard suit.
+func (ordering RankOrdering) Supports(cardType PlayingCardType) bool {
+	return cardType == French && (ordering.Trump == "" || indexOf(bridgeSuitOrder, ordering.Trump) >= 0)
+}
+
+// strength returns the cardStrength of card according to the RankOrdering.
+func (ordering RankOrdering) strength(card PlayingCard) cardStrength {
+	trumpLevel := 0
+	if ordering.Trump != "" && card.Suit == ordering.Trump {
+		trumpLevel = 1
+	}
+	return cardStrength{trumpLevel, indexOf(ordering.Ranks, card.Value), indexOf(bridgeSuitOrder, card.Suit)}
+}
+
+// Compare compares a and b according to the rules of Skat.
+func (ordering SkatOrdering) Compare(a PlayingCard, b PlayingCard) int {
+	return compareStrengths(ordering.strength(a), ordering.strength(b))
+}
+
+// Supports returns true if cardType is French or German, and if the Trump suit, if any, is a suit
+// of cardType.
+func (ordering SkatOrdering) Supports(cardType PlayingCardType) bool {
+	switch cardType {
+	case French:
+		return ordering.Trump == "" || indexOf(skatSuitOrder, ordering.Trump) >= 0
+	case German:
+		return ordering.Trump == "" || indexOf(germanSkatSuitOrder, ordering.Trump) >= 0
+	}
+	return false
+}
+
+// strength returns the cardStrength of card according to the SkatOrdering.
+func (ordering SkatOrdering) strength(card PlayingCard) cardStrength {
+	suitOrder, ranks := skatSuitOrder, SkatRanks[:]
+	if indexOf(germanSkatSuitOrder, card.Suit) >= 0 {
+		suitOrder, ranks = germanSkatSuitOrder, GermanSkatRanks[:]
+	}
+	suitRank := indexOf(suitOrder, card.Suit)
+	switch {
+	case card.Value == "JACK" || card.Value == "UNTER":
+		return cardStrength{2, 0, suitRank}
+	case ordering.Trump != "" && card.Suit == ordering.Trump:
+		return cardStrength{1, indexOf(ranks, card.Value), suitRank}
+	}
+	return cardStrength{0, indexOf(ranks, card.Value), suitRank}
+}
+
+// Compare compares a and b according to the rules of Euchre.
+func (ordering EuchreOrdering) Compare(a PlayingCard, b PlayingCard) int {
+	return compareStrengths(ordering.strength(a), ordering.strength(b))
+}
+
+// Supports returns true if cardType is French, and if the Trump suit is a FrenchCard suit.
+func (ordering EuchreOrdering) Supports(cardType PlayingCardType) bool {
+	return cardType == French && indexOf(bridgeSuitOrder, ordering.Trump) >= 0
+}
+
+// strength returns the cardStrength of card according to the EuchreOrdering.
+func (ordering EuchreOrdering) strength(card PlayingCard) cardStrength {
+	suitRank := indexOf(bridgeSuitOrder, card.Suit)
+	switch {
+	case card.Value == "JACK" && card.Suit == ordering.Trump:
+		return cardStrength{1, len(AceHighRanks) + 1, suitRank}
+	case ordering.isLeftBower(card):
+		return cardStrength{1, len(AceHighRanks), suitRank}
+	case card.Suit == ordering.Trump:
+		return cardStrength{1, indexOf(AceHighRanks[:], card.Value), suitRank}
+	}
+	return cardStrength{0, indexOf(AceHighRanks[:], card.Value), suitRank}
+}
+
+// isLeftBower returns true if card is the Jack of the suit of the same color as the Trump suit.
+func (ordering EuchreOrdering) isLeftBower(card PlayingCard) bool {
+	if card.Value != "JACK" || card.Suit == ordering.Trump || indexOf(bridgeSuitOrder, card.Suit) < 0 || ordering.Trump == "" {
+		return false
+	}
+	return frenchSuitColor(card.Suit) == frenchSuitColor(ordering.Trump)
+}
+
+// ParseOrdering parses and returns the Comparator described by specification, formatted as
+// "<ordering>[:<trump suit>]" e.g. "ace-high", "ace-low:hearts", "skat:clubs" or "euchre:spades".
+// The orderings are "ace-high", "ace-low", "skat", whose trump suit is optional, and "euchre",
+// whose trump suit is required. The trump suit is a FrenchCard suit, or a GermanCard suit for the
+// "skat" ordering e.g. "skat:eichel". specification is case-insensitive.
+// ParseOrdering fails with ErrInvalidOrdering if specification cannot be parsed.
+func ParseOrdering(specification string) (Comparator, error) {
+	name, trump, hasTrump := strings.Cut(strings.ToUpper(strings.TrimSpace(specification)), ":")
+	isGermanTrump := name == "SKAT" && indexOf(germanSkatSuitOrder, trump) >= 0
+	if hasTrump && indexOf(bridgeSuitOrder, trump) < 0 && !isGermanTrump {
+		return nil, fmt.Errorf("%w: unknown trump suit '%s'", ErrInvalidOrdering, trump)
+	}
+	switch name {
+	case "ACE-HIGH":
+		return RankOrdering{Ranks: AceHighRanks[:], Trump: trump}, nil
+	case "ACE-LOW":
+		return RankOrdering{Ranks: AceLowRanks[:], Trump: trump}, nil
+	case "SKAT":
+		return SkatOrdering{Trump: trump}, nil
+	case "EUCHRE":
+		if !hasTrump {
+			return nil, fmt.Errorf("%w: the euchre ordering requires a trump suit", ErrInvalidOrdering)
+		}
+		return EuchreOrdering{Trump: trump}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown ordering '%s'", ErrInvalidOrdering, strings.TrimSpace(specification))
+}
+
+// SortCards sorts playingCards in place from the lowest to the highest according to comparator.
+// The cards ranking equally keep their original order.
+func SortCards(playingCards []PlayingCard, comparator Comparator) {
+	sort.SliceStable(playingCards, func(i, j int) bool {
+		return comparator.Compare(playingCards[i], playingCards[j]) < 0
+	})
+}
+
+// compareStrengths compares the cardStrength a and b element by element.
+func compareStrengths(a cardStrength, b cardStrength) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return 0
+}
+
+// indexOf returns the index of value in values, or -1 if values does not contain value.
+func indexOf(values []string, value string) int {
+	for i, candidate := range values {
+		if candidate == value {
+			return i
+		}
+	}
+	return -1
+}
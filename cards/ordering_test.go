@@ -0,0 +1,157 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newOrderingTestCard(t *testing.T, code string) PlayingCard {
+	for _, suit := range FrenchCardSuits {
+		for _, value := range FrenchCardValues {
+			if card, _ := NewFrenchCard(suit.String(), value); card.Code == code {
+				return card.PlayingCard
+			}
+		}
+	}
+	for _, color := range FrenchJokerColors {
+		if card, _ := NewFrenchJoker(color.String()); card.Code == code {
+			return card.PlayingCard
+		}
+	}
+	t.Fatalf("unknown test card code '%s'", code)
+	return PlayingCard{}
+}
+
+func sortTestCards(t *testing.T, comparator Comparator, codes ...string) []string {
+	playingCards := make([]PlayingCard, 0, len(codes))
+	for _, code := range codes {
+		playingCards = append(playingCards, newOrderingTestCard(t, code))
+	}
+	SortCards(playingCards, comparator)
+	sortedCodes := make([]string, 0, len(playingCards))
+	for _, card := range playingCards {
+		sortedCodes = append(sortedCodes, card.Code)
+	}
+	return sortedCodes
+}
+
+func TestRankOrdering(t *testing.T) {
+	aceHigh := RankOrdering{Ranks: AceHighRanks[:]}
+	assert.Positive(t, aceHigh.Compare(newOrderingTestCard(t, "AS"), newOrderingTestCard(t, "KS")), "expected the Ace above the King")
+	assert.Negative(t, aceHigh.Compare(newOrderingTestCard(t, "9H"), newOrderingTestCard(t, "10C")), "expected the 9 below the 10")
+	assert.Zero(t, aceHigh.Compare(newOrderingTestCard(t, "QD"), newOrderingTestCard(t, "QD")), "expected identical cards to rank equally")
+	assert.Equal(t, []string{"JR", "2H", "10S", "KC", "KD", "AS"}, sortTestCards(t, aceHigh, "AS", "KD", "2H", "JR", "10S", "KC"))
+
+	aceLow := RankOrdering{Ranks: AceLowRanks[:]}
+	assert.Negative(t, aceLow.Compare(newOrderingTestCard(t, "AS"), newOrderingTestCard(t, "2S")), "expected the Ace below the 2")
+	assert.Equal(t, []string{"AS", "2H", "10S", "KD"}, sortTestCards(t, aceLow, "KD", "AS", "2H", "10S"))
+
+	heartsTrump := RankOrdering{Ranks: AceHighRanks[:], Trump: Hearts.String()}
+	assert.Positive(t, heartsTrump.Compare(newOrderingTestCard(t, "2H"), newOrderingTestCard(t, "AS")), "expected a trump above any other card")
+	assert.Equal(t, []string{"KD", "AS", "2H", "10H"}, sortTestCards(t, heartsTrump, "10H", "AS", "2H", "KD"))
+}
+
+func TestSkatOrdering(t *testing.T) {
+	clubsTrump := SkatOrdering{Trump: Clubs.String()}
+	assert.Equal(
+		t,
+		[]string{"7S", "10S", "AS", "7C", "QC", "10C", "AC", "JD", "JH", "JS", "JC"},
+		sortTestCards(t, clubsTrump, "AC", "JD", "7S", "JC", "10C", "AS", "QC", "JS", "10S", "7C", "JH"))
+
+	grand := SkatOrdering{}
+	assert.Positive(t, grand.Compare(newOrderingTestCard(t, "JD"), newOrderingTestCard(t, "AC")), "expected the Jacks to be the only trumps of a Grand")
+	assert.Positive(t, grand.Compare(newOrderingTestCard(t, "10H"), newOrderingTestCard(t, "KH")), "expected the 10 above the King")
+}
+
+func TestSkatOrderingWithGermanCards(t *testing.T) {
+	newGermanTestCard := func(suit GermanCardSuit, value string) PlayingCard {
+		card, err := NewGermanCard(suit.String(), value)
+		if err != nil {
+			t.Fatalf("unable to create the test card: %s", err)
+		}
+		return card.PlayingCard
+	}
+	playingCards := []PlayingCard{
+		newGermanTestCard(Eichel, "ASS"),
+		newGermanTestCard(Schellen, "UNTER"),
+		newGermanTestCard(Gruen, "7"),
+		newGermanTestCard(Eichel, "UNTER"),
+		newGermanTestCard(Eichel, "10"),
+		newGermanTestCard(Gruen, "ASS"),
+		newGermanTestCard(Eichel, "OBER"),
+		newGermanTestCard(Gruen, "10"),
+		newGermanTestCard(Herz, "UNTER"),
+	}
+	SortCards(playingCards, SkatOrdering{Trump: Eichel.String()})
+	sortedCodes := make([]string, 0, len(playingCards))
+	for _, card := range playingCards {
+		sortedCodes = append(sortedCodes, card.Code)
+	}
+	assert.Equal(t, []string{"7G", "10G", "AG", "OE", "10E", "AE", "US", "UH", "UE"}, sortedCodes)
+}
+
+func TestOrderingSupports(t *testing.T) {
+	testRecords := []struct {
+		comparator    Comparator
+		cardType      PlayingCardType
+		expectSupport bool
+	}{
+		{RankOrdering{Ranks: AceHighRanks[:]}, French, true},
+		{RankOrdering{Ranks: AceHighRanks[:]}, German, false},
+		{RankOrdering{Ranks: AceHighRanks[:]}, Spanish, false},
+		{SkatOrdering{}, French, true},
+		{SkatOrdering{}, German, true},
+		{SkatOrdering{Trump: "CLUBS"}, German, false},
+		{SkatOrdering{Trump: "EICHEL"}, German, true},
+		{SkatOrdering{Trump: "EICHEL"}, French, false},
+		{SkatOrdering{}, Italian, false},
+		{EuchreOrdering{Trump: "SPADES"}, French, true},
+		{EuchreOrdering{Trump: "SPADES"}, Uno, false},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectSupport, testRecord.comparator.Supports(testRecord.cardType), "expected the support of %v for %s cards", testRecord.comparator, testRecord.cardType)
+	}
+}
+
+func TestEuchreOrdering(t *testing.T) {
+	spadesTrump := EuchreOrdering{Trump: Spades.String()}
+	assert.Equal(
+		t,
+		[]string{"9H", "JH", "AD", "9S", "10S", "QS", "KS", "AS", "JC", "JS"},
+		sortTestCards(t, spadesTrump, "JC", "AS", "9H", "JS", "QS", "AD", "10S", "JH", "KS", "9S"))
+
+	heartsTrump := EuchreOrdering{Trump: Hearts.String()}
+	assert.Positive(t, heartsTrump.Compare(newOrderingTestCard(t, "JD"), newOrderingTestCard(t, "AH")), "expected the left bower above the Ace of trump")
+	assert.Negative(t, heartsTrump.Compare(newOrderingTestCard(t, "JD"), newOrderingTestCard(t, "JH")), "expected the left bower below the right bower")
+	assert.Negative(t, heartsTrump.Compare(newOrderingTestCard(t, "JC"), newOrderingTestCard(t, "9H")), "expected a black Jack below any trump")
+}
+
+func TestParseOrdering(t *testing.T) {
+	testRecords := []struct {
+		specification      string
+		expectedComparator Comparator
+	}{
+		{"ace-high", RankOrdering{Ranks: AceHighRanks[:]}},
+		{" Ace-Low ", RankOrdering{Ranks: AceLowRanks[:]}},
+		{"ace-high:hearts", RankOrdering{Ranks: AceHighRanks[:], Trump: "HEARTS"}},
+		{"skat", SkatOrdering{}},
+		{"SKAT:CLUBS", SkatOrdering{Trump: "CLUBS"}},
+		{"skat:eichel", SkatOrdering{Trump: "EICHEL"}},
+		{"ace-high:eichel", nil},
+		{"euchre:spades", EuchreOrdering{Trump: "SPADES"}},
+		{"euchre", nil},
+		{"ace-high:stars", nil},
+		{"bridge", nil},
+		{"", nil},
+	}
+	for _, testRecord := range testRecords {
+		comparator, err := ParseOrdering(testRecord.specification)
+		if testRecord.expectedComparator == nil {
+			assert.Nil(t, comparator, "expected no comparator for '%s'", testRecord.specification)
+			assert.ErrorIs(t, err, ErrInvalidOrdering)
+		} else {
+			assert.Nil(t, err, "expected no error for '%s'", testRecord.specification)
+			assert.Equal(t, testRecord.expectedComparator, comparator)
+		}
+	}
+}
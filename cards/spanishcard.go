@@ -0,0 +1,56 @@
+package cards
+
+// SpanishCard is the representation of a Spanish-suited playable card.
+type SpanishCard struct {
+	PlayingCard
+}
+
+var _ Card = &SpanishCard{}
+
+// SpanishCardSuit is the representation of a SpanishCard suit.
+type SpanishCardSuit string
+
+const (
+	Oros    SpanishCardSuit = "OROS"
+	Copas   SpanishCardSuit = "COPAS"
+	Espadas SpanishCardSuit = "ESPADAS"
+	Bastos  SpanishCardSuit = "BASTOS"
+)
+
+// String returns a stringified version of a SpanishCardSuit.
+func (suit SpanishCardSuit) String() string {
+	return string(suit)
+}
+
+// SpanishCardSuits is the definition of the SpanishCard suits panel.
+// SpanishCardSuits must not be modified to preserve the Spanish-suited playing card standards.
+var SpanishCardSuits = [4]SpanishCardSuit{Oros, Copas, Espadas, Bastos}
+
+// SpanishCardValues is the definition of the SpanishCard values panel of a 48-card deck.
+// The 40-card deck leaves out the 8 and the 9.
+// SpanishCardValues must not be modified to preserve the Spanish-suited playing card standards.
+var SpanishCardValues = [12]string{
+	"AS",
+	"2",
+	"3",
+	"4",
+	"5",
+	"6",
+	"7",
+	"8",
+	"9",
+	"SOTA",
+	"CABALLO",
+	"REY"}
+
+// NewSpanishCard creates and returns a SpanishCard based on the provided suit and value.
+// The code of a SpanishCard is made of its value, or the initial of its value if alphabetical,
+// followed by the initial of its suit e.g. "AO" for the As de Oros or "CE" for the Caballo de Espadas.
+// A successful NewSpanishCard returns err == nil.
+func NewSpanishCard(suit string, value string) (*SpanishCard, error) {
+	playingCard, err := NewPlayingCard(suit, value)
+	if err != nil {
+		return nil, err
+	}
+	return &SpanishCard{PlayingCard: *playingCard}, nil
+}
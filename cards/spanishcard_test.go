@@ -0,0 +1,58 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSpanishCardStringifiedSuit(t *testing.T) {
+	testRecords := []struct {
+		suit                    SpanishCardSuit
+		expectedStringifiedSuit string
+	}{
+		{Oros, "OROS"},
+		{Copas, "COPAS"},
+		{Espadas, "ESPADAS"},
+		{Bastos, "BASTOS"},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectedStringifiedSuit, testRecord.suit.String(), "expected identical enum representation")
+	}
+}
+
+func TestSpanishCardSuits(t *testing.T) {
+	expectedSuits := [4]SpanishCardSuit{Oros, Copas, Espadas, Bastos}
+	assert.Equal(t, expectedSuits, SpanishCardSuits)
+}
+
+func TestSpanishCardValues(t *testing.T) {
+	expectedValues := [12]string{"AS", "2", "3", "4", "5", "6", "7", "8", "9", "SOTA", "CABALLO", "REY"}
+	assert.Equal(t, expectedValues, SpanishCardValues)
+}
+
+func TestNewSpanishCard(t *testing.T) {
+	testRecords := []struct {
+		suit         string
+		value        string
+		expectedCode string
+	}{
+		{Oros.String(), "AS", "AO"},
+		{Copas.String(), "7", "7C"},
+		{Espadas.String(), "SOTA", "SE"},
+		{Bastos.String(), "CABALLO", "CB"},
+		{Copas.String(), "REY", "RC"},
+		{"", "REY", ""},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewSpanishCard(testRecord.suit, testRecord.value)
+		if testRecord.expectedCode == "" {
+			assert.Nil(t, card, "expected no card")
+			assert.NotNil(t, err, "expected an error")
+		} else {
+			assert.Nil(t, err, "expected no error")
+			assert.Equal(t, testRecord.expectedCode, card.Code, "expected the Spanish card code")
+			assert.Equal(t, testRecord.suit, card.Suit)
+			assert.Equal(t, testRecord.value, card.Value)
+		}
+	}
+}
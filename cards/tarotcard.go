@@ -0,0 +1,98 @@
+package cards
+
+import (
+	"errors"
+	"strconv"
+)
+
+// TarotCard is the representation of a French Tarot playable card.
+// A TarotCard is either a suited card, one of the trumps or the Excuse.
+type TarotCard struct {
+	PlayingCard
+}
+
+var _ Card = &TarotCard{}
+
+// TarotCardValues is the definition of the values panel of the suited TarotCard, which are
+// French-suited.
+// TarotCardValues must not be modified to preserve the French Tarot playing card standards.
+var TarotCardValues = [14]string{
+	"ACE",
+	"2",
+	"3",
+	"4",
+	"5",
+	"6",
+	"7",
+	"8",
+	"9",
+	"10",
+	"JACK",
+	"KNIGHT",
+	"QUEEN",
+	"KING"}
+
+const (
+	// TarotKnightValue is the value of the Knight, which ranks between the Jack and the Queen.
+	TarotKnightValue = "KNIGHT"
+	// TarotTrumpSuit is the suit of the trumps.
+	TarotTrumpSuit = "TRUMP"
+	// TarotTrumpCount is the number of trumps, valued from 1 to TarotTrumpCount.
+	TarotTrumpCount = 21
+	// TarotExcuseValue is the value of the Excuse, which also stands for its suit.
+	TarotExcuseValue = "EXCUSE"
+)
+
+// NewTarotCard creates and returns a suited TarotCard based on the provided suit and value.
+// A successful NewTarotCard returns err == nil.
+func NewTarotCard(suit string, value string) (*TarotCard, error) {
+	playingCard, err := NewPlayingCard(suit, value)
+	if err != nil {
+		return nil, err
+	}
+	card := TarotCard{PlayingCard: *playingCard}
+	card.Code = card.ComputeCode()
+	return &card, nil
+}
+
+// NewTarotTrump creates and returns the trump TarotCard of the provided number, from 1 to
+// TarotTrumpCount.
+// A successful NewTarotTrump returns err == nil.
+func NewTarotTrump(number int) (*TarotCard, error) {
+	if number < 1 || number > TarotTrumpCount {
+		return nil, errors.New("trump number out of bounds")
+	}
+	return NewTarotCard(TarotTrumpSuit, strconv.Itoa(number))
+}
+
+// NewTarotExcuse creates and returns the Excuse TarotCard.
+func NewTarotExcuse() *TarotCard {
+	card, _ := NewTarotCard(TarotExcuseValue, TarotExcuseValue)
+	return card
+}
+
+// ComputeCode determines and returns the code of a TarotCard.
+// The trumps are coded "T" followed by their number e.g. "T21", the Excuse is coded "EX", and the
+// Knight is coded "C", for Cavalier, followed by the initial of its suit e.g. "CH", so that it is
+// not mistaken for the King. The other cards are coded like a PlayingCard.
+func (card TarotCard) ComputeCode() string {
+	switch {
+	case card.IsTrump():
+		return "T" + card.Value
+	case card.IsExcuse():
+		return "EX"
+	case card.Value == TarotKnightValue:
+		return "C" + card.Suit[0:1]
+	}
+	return card.PlayingCard.ComputeCode()
+}
+
+// IsTrump returns true if the TarotCard is a trump.
+func (card TarotCard) IsTrump() bool {
+	return card.Suit == TarotTrumpSuit
+}
+
+// IsExcuse returns true if the TarotCard is the Excuse.
+func (card TarotCard) IsExcuse() bool {
+	return card.Value == TarotExcuseValue
+}
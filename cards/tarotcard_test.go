@@ -0,0 +1,56 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewTarotCard(t *testing.T) {
+	testRecords := []struct {
+		suit         string
+		value        string
+		expectedCode string
+	}{
+		{Spades.String(), "ACE", "AS"},
+		{Hearts.String(), "10", "10H"},
+		{Diamonds.String(), "JACK", "JD"},
+		{Clubs.String(), "KNIGHT", "CC"},
+		{Hearts.String(), "KNIGHT", "CH"},
+		{Hearts.String(), "KING", "KH"},
+		{Spades.String(), "", ""},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewTarotCard(testRecord.suit, testRecord.value)
+		if testRecord.expectedCode == "" {
+			assert.Nil(t, card, "expected no card")
+			assert.NotNil(t, err, "expected an error")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, testRecord.expectedCode, card.Code, "expected the Tarot card code")
+		assert.False(t, card.IsTrump(), "expected a suited card")
+		assert.False(t, card.IsExcuse(), "expected a suited card")
+	}
+}
+
+func TestNewTarotTrump(t *testing.T) {
+	for number := 1; number <= TarotTrumpCount; number++ {
+		card, err := NewTarotTrump(number)
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, TarotTrumpSuit, card.Suit)
+		assert.True(t, card.IsTrump(), "expected a trump")
+		assert.Equal(t, "T"+card.Value, card.Code, "expected the trump code")
+	}
+	for _, number := range []int{0, TarotTrumpCount + 1} {
+		card, err := NewTarotTrump(number)
+		assert.Nil(t, card, "expected no card")
+		assert.NotNil(t, err, "expected an error for trump %d", number)
+	}
+}
+
+func TestNewTarotExcuse(t *testing.T) {
+	card := NewTarotExcuse()
+	assert.Equal(t, "EX", card.Code)
+	assert.True(t, card.IsExcuse(), "expected the Excuse")
+	assert.False(t, card.IsTrump(), "expected the Excuse not to be a trump")
+}
@@ -0,0 +1,131 @@
+package cards
+
+import "errors"
+
+// UnoCard is the representation of an Uno playable card.
+// An UnoCard is either a colored card, numbered or action, or a wild card.
+type UnoCard struct {
+	PlayingCard
+}
+
+var _ Card = &UnoCard{}
+
+// UnoCardColor is the representation of an UnoCard color, which stands for its suit.
+type UnoCardColor string
+
+const (
+	UnoRed    UnoCardColor = "RED"
+	UnoYellow UnoCardColor = "YELLOW"
+	UnoGreen  UnoCardColor = "GREEN"
+	UnoBlue   UnoCardColor = "BLUE"
+)
+
+// String returns a stringified version of an UnoCardColor.
+func (color UnoCardColor) String() string {
+	return string(color)
+}
+
+// UnoCardColors is the definition of the UnoCard colors panel.
+// UnoCardColors must not be modified to preserve the Uno playing card standards.
+var UnoCardColors = [4]UnoCardColor{UnoRed, UnoYellow, UnoGreen, UnoBlue}
+
+const (
+	// UnoSkipValue is the value of the action card skipping the next player.
+	UnoSkipValue = "SKIP"
+	// UnoReverseValue is the value of the action card reversing the direction of play.
+	UnoReverseValue = "REVERSE"
+	// UnoDrawTwoValue is the value of the action card making the next player draw two cards.
+	UnoDrawTwoValue = "DRAW_TWO"
+	// UnoWildSuit is the suit of the wild cards, which have no color.
+	UnoWildSuit = "WILD"
+	// UnoWildValue is the value of the wild card choosing the color of play.
+	UnoWildValue = "WILD"
+	// UnoWildDrawFourValue is the value of the wild card choosing the color of play and making the
+	// next player draw four cards.
+	UnoWildDrawFourValue = "WILD_DRAW_FOUR"
+)
+
+// UnoCardValues is the definition of the values panel of the colored UnoCard.
+// UnoCardValues must not be modified to preserve the Uno playing card standards.
+var UnoCardValues = [13]string{
+	"0",
+	"1",
+	"2",
+	"3",
+	"4",
+	"5",
+	"6",
+	"7",
+	"8",
+	"9",
+	UnoSkipValue,
+	UnoReverseValue,
+	UnoDrawTwoValue}
+
+// UnoWildCardValues is the definition of the values panel of the wild UnoCard.
+// UnoWildCardValues must not be modified to preserve the Uno playing card standards.
+var UnoWildCardValues = [2]string{UnoWildValue, UnoWildDrawFourValue}
+
+// NewUnoCard creates and returns a colored UnoCard based on the provided color and value.
+// A successful NewUnoCard returns err == nil.
+func NewUnoCard(color string, value string) (*UnoCard, error) {
+	playingCard, err := NewPlayingCard(color, value)
+	if err != nil {
+		return nil, err
+	}
+	card := UnoCard{PlayingCard: *playingCard}
+	card.Code = card.ComputeCode()
+	return &card, nil
+}
+
+// NewUnoWildCard creates and returns the wild UnoCard of the provided value, UnoWildValue or
+// UnoWildDrawFourValue.
+// A successful NewUnoWildCard returns err == nil.
+func NewUnoWildCard(value string) (*UnoCard, error) {
+	if value != UnoWildValue && value != UnoWildDrawFourValue {
+		return nil, errors.New("unknown wild card value")
+	}
+	return NewUnoCard(UnoWildSuit, value)
+}
+
+// ComputeCode determines and returns the code of an UnoCard.
+// The Draw Two is coded "D2" followed by the initial of its color e.g. "D2R", the Wild is coded
+// "W" and the Wild Draw Four "W4". The other cards are coded like a PlayingCard e.g. "7G" or "SB".
+// The copies of a same card share their code.
+func (card UnoCard) ComputeCode() string {
+	switch {
+	case card.Value == UnoDrawTwoValue:
+		return "D2" + card.Suit[0:1]
+	case card.Value == UnoWildValue:
+		return "W"
+	case card.Value == UnoWildDrawFourValue:
+		return "W4"
+	}
+	return card.PlayingCard.ComputeCode()
+}
+
+// IsWild returns true if the UnoCard is a wild card.
+func (card UnoCard) IsWild() bool {
+	return card.Suit == UnoWildSuit
+}
+
+// IsAction returns true if the UnoCard is an action card, wild cards included.
+func (card UnoCard) IsAction() bool {
+	switch card.Value {
+	case UnoSkipValue, UnoReverseValue, UnoDrawTwoValue, UnoWildValue, UnoWildDrawFourValue:
+		return true
+	}
+	return false
+}
+
+// Copies returns the number of copies of the UnoCard in an Uno deck: a single copy of every 0, four
+// copies of every wild card, and two copies of the other cards.
+func (card UnoCard) Copies() int {
+	switch {
+	case card.Value == "0":
+		return 1
+	case card.IsWild():
+		return 4
+	}
+	return 2
+}
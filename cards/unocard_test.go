@@ -0,0 +1,81 @@
+package cards
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUnoCardStringifiedColor(t *testing.T) {
+	testRecords := []struct {
+		color                    UnoCardColor
+		expectedStringifiedColor string
+	}{
+		{UnoRed, "RED"},
+		{UnoYellow, "YELLOW"},
+		{UnoGreen, "GREEN"},
+		{UnoBlue, "BLUE"},
+	}
+	for _, testRecord := range testRecords {
+		assert.Equal(t, testRecord.expectedStringifiedColor, testRecord.color.String(), "expected identical enum representation")
+	}
+}
+
+func TestUnoCardValues(t *testing.T) {
+	expectedValues := [13]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "SKIP", "REVERSE", "DRAW_TWO"}
+	assert.Equal(t, expectedValues, UnoCardValues)
+}
+
+func TestNewUnoCard(t *testing.T) {
+	testRecords := []struct {
+		color          string
+		value          string
+		expectedCode   string
+		expectedAction bool
+		expectedCopies int
+	}{
+		{UnoRed.String(), "0", "0R", false, 1},
+		{UnoYellow.String(), "7", "7Y", false, 2},
+		{UnoGreen.String(), UnoSkipValue, "SG", true, 2},
+		{UnoBlue.String(), UnoReverseValue, "RB", true, 2},
+		{UnoRed.String(), UnoDrawTwoValue, "D2R", true, 2},
+		{UnoRed.String(), "", "", false, 0},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewUnoCard(testRecord.color, testRecord.value)
+		if testRecord.expectedCode == "" {
+			assert.Nil(t, card, "expected no card")
+			assert.NotNil(t, err, "expected an error")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, testRecord.expectedCode, card.Code, "expected the Uno card code")
+		assert.Equal(t, testRecord.expectedAction, card.IsAction())
+		assert.Equal(t, testRecord.expectedCopies, card.Copies())
+		assert.False(t, card.IsWild(), "expected a colored card")
+	}
+}
+
+func TestNewUnoWildCard(t *testing.T) {
+	testRecords := []struct {
+		value        string
+		expectedCode string
+	}{
+		{UnoWildValue, "W"},
+		{UnoWildDrawFourValue, "W4"},
+		{UnoSkipValue, ""},
+	}
+	for _, testRecord := range testRecords {
+		card, err := NewUnoWildCard(testRecord.value)
+		if testRecord.expectedCode == "" {
+			assert.Nil(t, card, "expected no card")
+			assert.NotNil(t, err, "expected an error")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, testRecord.expectedCode, card.Code, "expected the wild card code")
+		assert.Equal(t, UnoWildSuit, card.Suit)
+		assert.True(t, card.IsWild(), "expected a wild card")
+		assert.True(t, card.IsAction(), "expected a wild card to be an action card")
+		assert.Equal(t, 4, card.Copies())
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StorageType is the representation of the backend used to store the decks.
+type StorageType string
+
+const (
+	MemoryStorage StorageType = "memory"
+	FileStorage   StorageType = "file"
+)
+
+const (
+	// defaultStorageDirectory is the directory in which the decks are persisted if none is configured.
+	defaultStorageDirectory = "data"
+	// defaultDeckTTL is the inactivity period after which a deck expires if none is requested.
+	defaultDeckTTL = 24 * time.Hour
+	// defaultSweepInterval is the period between two evictions of the expired decks.
+	defaultSweepInterval = time.Minute
+	// defaultExpiredDeckRetention is the period during which an expired deck is kept before its eviction.
+	defaultExpiredDeckRetention = time.Hour
+)
+
+// Config is the representation of the API configuration.
+//
+// DeckTTL is the inactivity period after which a deck expires, unless requested otherwise upon its
+// creation. The decks never expire by default if DeckTTL is zero.
+//
+// ExpiredDeckRetention is the period during which an expired deck is still reported as expired
+// before being evicted by the sweeper running every SweepInterval.
+type Config struct {
+	Storage              StorageType
+	StorageDirectory     string
+	DeckTTL              time.Duration
+	SweepInterval        time.Duration
+	ExpiredDeckRetention time.Duration
+}
+
+// LoadConfig creates and returns a Config based on the environment variables:
+//   - STORAGE selects the StorageType, MemoryStorage by default.
+//   - STORAGE_DIR selects the directory used by FileStorage, defaultStorageDirectory by default.
+//   - DECK_TTL selects the DeckTTL, defaultDeckTTL by default.
+//   - SWEEP_INTERVAL selects the SweepInterval, defaultSweepInterval by default.
+//   - EXPIRED_DECK_RETENTION selects the ExpiredDeckRetention, defaultExpiredDeckRetention by default.
+//
+// The periods are formatted as durations e.g. "90s" or "12h".
+// LoadConfig fails if a period cannot be parsed or is negative.
+func LoadConfig() (Config, error) {
+	config := Config{
+		Storage:              MemoryStorage,
+		StorageDirectory:     defaultStorageDirectory,
+		DeckTTL:              defaultDeckTTL,
+		SweepInterval:        defaultSweepInterval,
+		ExpiredDeckRetention: defaultExpiredDeckRetention,
+	}
+	if storage := os.Getenv("STORAGE"); storage != "" {
+		config.Storage = StorageType(storage)
+	}
+	if storageDirectory := os.Getenv("STORAGE_DIR"); storageDirectory != "" {
+		config.StorageDirectory = storageDirectory
+	}
+	periods := []struct {
+		variable string
+		period   *time.Duration
+	}{
+		{"DECK_TTL", &config.DeckTTL},
+		{"SWEEP_INTERVAL", &config.SweepInterval},
+		{"EXPIRED_DECK_RETENTION", &config.ExpiredDeckRetention},
+	}
+	for _, period := range periods {
+		value := os.Getenv(period.variable)
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration < 0 {
+			return Config{}, fmt.Errorf("invalid %s '%s'", period.variable, value)
+		}
+		*period.period = duration
+	}
+	if config.SweepInterval == 0 {
+		return Config{}, fmt.Errorf("invalid SWEEP_INTERVAL '%s'", config.SweepInterval)
+	}
+	return config, nil
+}
+
+// NewDeckRepository creates and returns the DeckRepository selected by config.
+// NewDeckRepository can fail if the StorageType is not handled or if the storage cannot be
+// initialized.
+func NewDeckRepository(config Config) (DeckRepository, error) {
+	switch config.Storage {
+	case MemoryStorage:
+		return NewMemoryDeckRepository(), nil
+	case FileStorage:
+		repository, err := NewFileDeckRepository(config.StorageDirectory)
+		if err != nil {
+			return nil, err
+		}
+		return repository, nil
+	}
+	return nil, fmt.Errorf("unsupported storage '%s'", config.Storage)
+}
+
+// NewTemplateRepository creates and returns the TemplateRepository selected by config.
+// The templates of a FileStorage are stored in the templates subdirectory of the storage
+// directory.
+// NewTemplateRepository can fail if the StorageType is not handled or if the storage cannot be
+// initialized.
+func NewTemplateRepository(config Config) (TemplateRepository, error) {
+	switch config.Storage {
+	case MemoryStorage:
+		return NewMemoryTemplateRepository(), nil
+	case FileStorage:
+		repository, err := NewFileTemplateRepository(filepath.Join(config.StorageDirectory, templateDirectoryName))
+		if err != nil {
+			return nil, err
+		}
+		return repository, nil
+	}
+	return nil, fmt.Errorf("unsupported storage '%s'", config.Storage)
+}
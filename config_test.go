@@ -0,0 +1,106 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadDefaultConfig(t *testing.T) {
+	for _, variable := range []string{"STORAGE", "STORAGE_DIR", "DECK_TTL", "SWEEP_INTERVAL", "EXPIRED_DECK_RETENTION"} {
+		t.Setenv(variable, "")
+	}
+
+	config, err := LoadConfig()
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, Config{
+		Storage:              MemoryStorage,
+		StorageDirectory:     defaultStorageDirectory,
+		DeckTTL:              defaultDeckTTL,
+		SweepInterval:        defaultSweepInterval,
+		ExpiredDeckRetention: defaultExpiredDeckRetention,
+	}, config)
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("STORAGE", "file")
+	t.Setenv("STORAGE_DIR", "/var/lib/croupier")
+	t.Setenv("DECK_TTL", "0")
+	t.Setenv("SWEEP_INTERVAL", "30s")
+	t.Setenv("EXPIRED_DECK_RETENTION", "2h")
+
+	config, err := LoadConfig()
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, Config{
+		Storage:              FileStorage,
+		StorageDirectory:     "/var/lib/croupier",
+		DeckTTL:              0,
+		SweepInterval:        30 * time.Second,
+		ExpiredDeckRetention: 2 * time.Hour,
+	}, config)
+}
+
+func TestLoadInvalidConfig(t *testing.T) {
+	testRecords := []struct {
+		variable string
+		value    string
+	}{
+		{"DECK_TTL", "forever"},
+		{"DECK_TTL", "-1h"},
+		{"SWEEP_INTERVAL", "0s"},
+		{"EXPIRED_DECK_RETENTION", "1"},
+	}
+	for _, testRecord := range testRecords {
+		t.Run(testRecord.variable+"="+testRecord.value, func(t *testing.T) {
+			t.Setenv(testRecord.variable, testRecord.value)
+
+			_, err := LoadConfig()
+			assert.NotNil(t, err, "expected an error")
+		})
+	}
+}
+
+func TestNewDeckRepository(t *testing.T) {
+	testRecords := []struct {
+		config             Config
+		expectedRepository DeckRepository
+	}{
+		{Config{Storage: MemoryStorage}, &MemoryDeckRepository{}},
+		{Config{Storage: FileStorage, StorageDirectory: filepath.Join(t.TempDir(), "decks")}, &FileDeckRepository{}},
+		{Config{Storage: "unknown"}, nil},
+	}
+	for _, testRecord := range testRecords {
+		repository, err := NewDeckRepository(testRecord.config)
+		if testRecord.expectedRepository == nil {
+			assert.Nil(t, repository, "expected no repository")
+			assert.NotNil(t, err, "expected an error")
+		} else {
+			assert.Nil(t, err, "expected no error")
+			assert.IsType(t, testRecord.expectedRepository, repository)
+		}
+	}
+}
+
+func TestNewTemplateRepository(t *testing.T) {
+	storageDirectory := t.TempDir()
+	testRecords := []struct {
+		config             Config
+		expectedRepository TemplateRepository
+	}{
+		{Config{Storage: MemoryStorage}, &MemoryTemplateRepository{}},
+		{Config{Storage: FileStorage, StorageDirectory: storageDirectory}, &FileTemplateRepository{}},
+		{Config{Storage: "unknown"}, nil},
+	}
+	for _, testRecord := range testRecords {
+		repository, err := NewTemplateRepository(testRecord.config)
+		if testRecord.expectedRepository == nil {
+			assert.Nil(t, repository, "expected no repository")
+			assert.NotNil(t, err, "expected an error")
+		} else {
+			assert.Nil(t, err, "expected no error")
+			assert.IsType(t, testRecord.expectedRepository, repository)
+		}
+	}
+	assert.DirExists(t, filepath.Join(storageDirectory, templateDirectoryName), "expected the templates to be stored apart from the decks")
+}
@@ -6,7 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
-	"math/rand"
+	"strings"
 	"time"
 )
 
@@ -15,43 +15,295 @@ import (
 // Shuffle shuffles the cards contained in a deck.
 // Shuffle must modify the cards contained in the deck.
 //
+// ShuffleWithSeed shuffles the cards contained in a deck in a reproducible way.
+// ShuffleWithSeed must always produce the same order out of the same cards and seed.
+//
+// SetShuffleSequence sets the ShuffleSequence used by the next shuffles of a deck.
+// SetShuffleSequence must fail if the sequence cannot be parsed.
+//
+// Gather puts every card drawn, discarded or added to a pile from a deck back in the deck.
+//
+// Cut splits the cards contained in a deck at a position and puts the bottom part on top.
+// Cut must fail if the position does not leave cards in both parts.
+//
 // DrawCard pulls a specific number of cards from the cards contained in a deck, if any.
 // The cards that are drawn must be removed from the deck and must be returned.
+//
+// DrawCardFrom pulls a specific number of cards from a DrawPosition in the cards contained in a
+// deck, if any. The cards that are drawn must be removed from the deck and must be returned.
+//
+// DrawCardStrictly pulls exactly a specific number of cards from a DrawPosition in the cards
+// contained in a deck. DrawCardStrictly must fail, without drawing any card, if the number of
+// cards is not positive or if fewer cards remain in the deck.
+//
+// DrawCardByCodes pulls specific cards from the cards contained in a deck.
+// DrawCardByCodes must fail if any of the cards is not remaining in the deck.
+//
+// Peek retrieves a specific number of cards from the top of the cards contained in a deck,
+// without removing them from the deck.
+//
+// Discard moves cards previously drawn from a deck to its discard pile.
+// Discard must fail if any of the cards has not been drawn from the deck.
+//
+// Return puts cards previously drawn or discarded from a deck back in the deck at a ReturnPosition.
+// Return must fail if any of the cards has not been drawn nor discarded from the deck.
+//
+// ReshuffleDiscarded shuffles the discard pile back into a deck.
+//
+// ReshuffleDiscardedWithSeed shuffles the discard pile back into a deck in a reproducible way.
+//
+// DrawToPile pulls a specific number of cards from a deck into a named pile of the deck.
+//
+// Pile retrieves the cards contained in a named pile of a deck.
+//
+// DrawFromPile pulls a specific number of cards from a named pile of a deck.
+//
+// DrawFromPileByCodes pulls specific cards from a named pile of a deck.
+//
+// MoveCards moves specific cards from a named pile of a deck to another one.
+//
+// Close closes a deck, so that its secrets can be revealed.
+//
+// Reveal retrieves the commitment made upon the shuffle of a deck, including its secrets.
+// Reveal must fail until the deck is exhausted or closed.
 type Deck interface {
 	Shuffle()
+	ShuffleWithSeed(int64)
+	SetShuffleSequence(string) error
+	Gather()
+	Cut(int) error
 	DrawCard(int) []cards.PlayingCard
+	DrawCardFrom(int, DrawPosition) []cards.PlayingCard
+	DrawCardStrictly(int, DrawPosition) ([]cards.PlayingCard, error)
+	DrawCardByCodes([]string) ([]cards.PlayingCard, error)
+	Peek(int) []cards.PlayingCard
+	Discard([]string) ([]cards.PlayingCard, error)
+	Return([]string, ReturnPosition) ([]cards.PlayingCard, error)
+	ReshuffleDiscarded()
+	ReshuffleDiscardedWithSeed(int64)
+	DrawToPile(string, int) ([]cards.PlayingCard, error)
+	Pile(string) ([]cards.PlayingCard, error)
+	DrawFromPile(string, int) ([]cards.PlayingCard, error)
+	DrawFromPileByCodes(string, []string) ([]cards.PlayingCard, error)
+	MoveCards(string, string, []string) ([]cards.PlayingCard, error)
+	Close()
+	Reveal() (*Fairness, error)
 }
 
 // PlayableDeck is the representation of a deck entity.
 // PlayableDeck should be defined in any specific type of deck.
+// Type is the type of the cards of the deck, and Variant the variant of the type of deck, if any.
+// TemplateID is the ID of the Template the deck was created from, if any.
+// ShuffleMode is the source of randomness used to shuffle the deck, SeededShuffle by default.
+// ShuffleSequence is the specification of the ShuffleSequence used to shuffle the deck, a uniform
+// shuffle if empty.
+// Seed is the seed of the latest shuffle of the deck, if any, allowing the shuffle to be replayed.
+// Fairness is the commitment made upon the shuffle of a provably fair deck, if any.
+// Closed is true once the deck has been closed.
+// TTL is the number of seconds of inactivity after which the deck expires, if positive.
+// ExpiresAt is the time at which the deck expires, if any.
+// StrictDraw is true if the draws from the deck must be fulfilled exactly or rejected.
+// Drawn holds the cards drawn from the deck which have neither been discarded nor returned.
+// Discarded holds the discard pile of the deck.
+// Piles holds the named piles of the deck e.g. the hands of the players, by name.
+// History holds the latest operations which rearranged the cards of the deck, e.g. shuffles and cuts.
 type PlayableDeck struct {
-	ID        uuid.UUID           `json:"deck_id"`
-	Cards     []cards.PlayingCard `json:"cards"`
-	Shuffled  bool                `json:"shuffled"`
-	Remaining int                 `json:"remaining"`
+	ID              uuid.UUID                      `json:"deck_id"`
+	Type            cards.PlayingCardType          `json:"type"`
+	Variant         string                         `json:"variant,omitempty"`
+	TemplateID      string                         `json:"template_id,omitempty"`
+	Cards           []cards.PlayingCard            `json:"cards"`
+	Shuffled        bool                           `json:"shuffled"`
+	ShuffleMode     ShuffleMode                    `json:"shuffle_mode,omitempty"`
+	ShuffleSequence string                         `json:"shuffle_sequence,omitempty"`
+	Seed            *int64                         `json:"seed,omitempty"`
+	Fairness        *Fairness                      `json:"fairness,omitempty"`
+	Closed          bool                           `json:"closed,omitempty"`
+	StrictDraw      bool                           `json:"strict_draw,omitempty"`
+	Remaining       int                            `json:"remaining"`
+	Drawn           []cards.PlayingCard            `json:"drawn"`
+	Discarded       []cards.PlayingCard            `json:"discarded"`
+	Piles           map[string][]cards.PlayingCard `json:"piles,omitempty"`
+	History         []Operation                    `json:"history,omitempty"`
+	TTL             int                            `json:"ttl,omitempty"`
+	ExpiresAt       *time.Time                     `json:"expires_at,omitempty"`
 }
 
+// MaxDeckCount is the maximum number of decks which can be combined into a single PlayableDeck.
+const MaxDeckCount = 8
+
 // CreationRequest is the representation of a request used to create a PlayableDeck.
+// Variant is the variant of the type of deck, e.g. "pinochle" for a French deck, "40" or "48" for
+// a Spanish deck or "32" or "36" for a German deck; the standard
+// deck of the type is created if Variant is empty.
+// TemplateID is the ID of the Template of a Custom PlayableDeck.
+// Count is the number of decks combined into the PlayableDeck, e.g. to create a shoe; a single
+// deck is created if Count is zero.
+// ShuffleMode is the source of randomness used to shuffle the PlayableDeck, SeededShuffle by default.
+// Seed is the seed used to shuffle the PlayableDeck, if shuffled; a random seed is used if Seed
+// is nil. Seed is not applicable to a SecureShuffle.
+// ShuffleSequence is the specification of the ShuffleSequence used to shuffle the PlayableDeck, e.g.
+// "riffle x7, cut"; a uniform shuffle is used if ShuffleSequence is empty.
+// ProvablyFair shuffles the PlayableDeck with a secret server seed mixed with ClientSeed, and
+// commits to the resulting order. ProvablyFair only applies to a SeededShuffle without Seed.
+// StrictDraw rejects the draws from the PlayableDeck which cannot be fulfilled exactly, instead of
+// drawing the remaining cards.
+// Jokers adds the jokers to a French PlayableDeck.
+// Points overrides the default points of the cards of a French PlayableDeck, by card value or code.
+// TTL is the number of seconds of inactivity after which the deck expires; the deck never
+// expires if TTL is zero.
 type CreationRequest struct {
-	PlayingType cards.PlayingCardType `json:"type"`
-	Shuffled    bool                  `json:"shuffled"`
+	PlayingType     cards.PlayingCardType  `json:"type"`
+	Variant         string                 `json:"variant"`
+	TemplateID      string                 `json:"template_id"`
+	Shuffled        bool                   `json:"shuffled"`
+	ShuffleMode     ShuffleMode            `json:"shuffle_mode"`
+	ShuffleSequence string                 `json:"shuffle_sequence"`
+	Seed            *int64                 `json:"seed"`
+	ProvablyFair    bool                   `json:"provably_fair"`
+	ClientSeed      string                 `json:"client_seed"`
+	StrictDraw      bool                   `json:"strict_draw"`
+	Jokers          bool                   `json:"jokers"`
+	Points          cards.FrenchCardPoints `json:"points"`
+	Count           int                    `json:"count"`
+	TTL             int                    `json:"ttl"`
 }
 
+// ErrInvalidCreationRequest is returned when a CreationRequest cannot be fulfilled.
+var ErrInvalidCreationRequest = errors.New("invalid creation request")
+
 var _ Deck = &PlayableDeck{}
 
-// Shuffle shuffles the cards contained in a playable deck.
-// Shuffle sets Shuffled to true.
+// Validate ensures the creation request can be fulfilled.
+// Validate fails with ErrInvalidCreationRequest if the requested number of decks is out of
+// bounds, if the requested TTL is negative, if the requested variant or shuffle is not supported.
+// A ShuffleSequence only applies to a shuffled deck, and Jokers and Points only apply to a French
+// deck.
+func (creationRequest CreationRequest) Validate() error {
+	if creationRequest.Count < 0 || creationRequest.Count > MaxDeckCount {
+		return fmt.Errorf("%w: the count must be between 0 and %d (0 for a single deck)", ErrInvalidCreationRequest, MaxDeckCount)
+	}
+	if creationRequest.TTL < 0 {
+		return fmt.Errorf("%w: the ttl must not be negative", ErrInvalidCreationRequest)
+	}
+	if (creationRequest.PlayingType == cards.Custom) != (creationRequest.TemplateID != "") {
+		return fmt.Errorf("%w: a custom deck must be created from a template", ErrInvalidCreationRequest)
+	}
+	if err := validateVariant(creationRequest.PlayingType, creationRequest.Variant); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCreationRequest, err.Error())
+	}
+	if creationRequest.Jokers && creationRequest.PlayingType != cards.French {
+		return fmt.Errorf("%w: jokers only apply to a french deck", ErrInvalidCreationRequest)
+	}
+	if len(creationRequest.Points) > 0 {
+		if creationRequest.PlayingType != cards.French {
+			return fmt.Errorf("%w: points only apply to a french deck", ErrInvalidCreationRequest)
+		}
+		if err := creationRequest.Points.Validate(); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidCreationRequest, err.Error())
+		}
+	}
+	switch creationRequest.ShuffleMode {
+	case "", SeededShuffle:
+	case SecureShuffle:
+		if creationRequest.Seed != nil {
+			return fmt.Errorf("%w: a secure shuffle cannot be seeded", ErrInvalidCreationRequest)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported shuffle mode '%s'", ErrInvalidCreationRequest, creationRequest.ShuffleMode)
+	}
+	if creationRequest.ShuffleSequence != "" {
+		if !creationRequest.Shuffled {
+			return fmt.Errorf("%w: a shuffle sequence only applies to a shuffled deck", ErrInvalidCreationRequest)
+		}
+		if _, err := ParseShuffleSequence(creationRequest.ShuffleSequence); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidCreationRequest, err.Error())
+		}
+	}
+	if creationRequest.ProvablyFair {
+		if !creationRequest.Shuffled {
+			return fmt.Errorf("%w: a provably fair deck must be shuffled", ErrInvalidCreationRequest)
+		}
+		if creationRequest.Seed != nil || creationRequest.ShuffleMode == SecureShuffle {
+			return fmt.Errorf("%w: a provably fair deck can only be shuffled with a server seed", ErrInvalidCreationRequest)
+		}
+	} else if creationRequest.ClientSeed != "" {
+		return fmt.Errorf("%w: a client seed only applies to a provably fair deck", ErrInvalidCreationRequest)
+	}
+	return nil
+}
+
+// Shuffle shuffles the cards contained in a playable deck according to its ShuffleMode and
+// ShuffleSequence.
+// A SecureShuffle relies on a cryptographically secure random generator and cannot be replayed.
+// Any other shuffle relies on a random seed which can be used to replay it.
+// Shuffle sets Shuffled to true and Seed to the random seed, if any.
 func (deck *PlayableDeck) Shuffle() {
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(deck.Cards), func(i, j int) { deck.Cards[i], deck.Cards[j] = deck.Cards[j], deck.Cards[i] })
+	if deck.ShuffleMode == SecureShuffle {
+		deck.shuffleCards(secureRandomSource{}, nil)
+		return
+	}
+	deck.ShuffleWithSeed(newSeed())
+}
+
+// ShuffleWithSeed shuffles the cards contained in a playable deck with a random number generator
+// dedicated to the deck and initialized with seed, according to its ShuffleSequence.
+// ShuffleWithSeed always produces the same order out of the same cards and seed.
+// ShuffleWithSeed sets Shuffled to true and Seed to seed.
+func (deck *PlayableDeck) ShuffleWithSeed(seed int64) {
+	deck.shuffleCards(newSeededRandomSource(seed), &seed)
+}
+
+// shuffleCards shuffles the cards contained in a playable deck with source, according to its
+// ShuffleSequence, and records the shuffle in History.
+// shuffleCards sets Shuffled to true and Seed to seed.
+func (deck *PlayableDeck) shuffleCards(source RandomSource, seed *int64) {
+	deck.shuffleSequence().Shuffle(deck.Cards, source)
 	deck.Shuffled = true
+	deck.Seed = seed
+	var operationSeed *int64
+	if seed != nil {
+		copiedSeed := *seed
+		operationSeed = &copiedSeed
+	}
+	deck.record(Operation{Type: ShuffleOperation, Seed: operationSeed, ShuffleSequence: deck.ShuffleSequence})
+}
+
+// SetShuffleSequence sets the ShuffleSequence used by the next shuffles of a playable deck from its
+// specification; an empty specification restores a uniform shuffle.
+// SetShuffleSequence fails with ErrInvalidShuffleSequence if specification cannot be parsed.
+func (deck *PlayableDeck) SetShuffleSequence(specification string) error {
+	if _, err := ParseShuffleSequence(specification); err != nil {
+		return err
+	}
+	deck.ShuffleSequence = specification
+	return nil
+}
+
+// shuffleSequence returns the ShuffleSequence used to shuffle a playable deck.
+// shuffleSequence falls back to a uniform shuffle if ShuffleSequence cannot be parsed.
+func (deck *PlayableDeck) shuffleSequence() ShuffleSequence {
+	sequence, err := ParseShuffleSequence(deck.ShuffleSequence)
+	if err != nil {
+		return ShuffleSequence{}
+	}
+	return sequence
 }
 
 // DrawCard pulls a specific number of cards from the cards contained in a deck, if any.
-// The cards that are drawn are removed from the deck and are returned.
+// The cards that are drawn are removed from the deck, are kept track of in Drawn and are returned.
 // DrawCard keeps track of Remaining and sets it to the number of cards which remained in the
 // deck after the draw.
 func (deck *PlayableDeck) DrawCard(requestedDrawCardCount int) []cards.PlayingCard {
+	playingCards := deck.drawCards(requestedDrawCardCount)
+	deck.Drawn = append(deck.Drawn, playingCards...)
+	return playingCards
+}
+
+// drawCards pulls a specific number of cards from the cards contained in a deck, if any.
+// The cards that are drawn are removed from the deck and are returned.
+// drawCards keeps track of Remaining.
+func (deck *PlayableDeck) drawCards(requestedDrawCardCount int) []cards.PlayingCard {
 	if requestedDrawCardCount <= 0 || len(deck.Cards) == 0 {
 		return make([]cards.PlayingCard, 0)
 	}
@@ -68,24 +320,204 @@ func (deck *PlayableDeck) DrawCard(requestedDrawCardCount int) []cards.PlayingCa
 	return playingCards
 }
 
+// Touch postpones the expiry of the playable deck to TTL seconds after now.
+// Touch has no effect if the playable deck does not expire.
+func (deck *PlayableDeck) Touch(now time.Time) {
+	if deck.TTL <= 0 {
+		return
+	}
+	expiresAt := now.Add(time.Duration(deck.TTL) * time.Second).UTC().Round(0)
+	deck.ExpiresAt = &expiresAt
+}
+
+// IsExpired returns true if the playable deck has expired at now.
+func (deck *PlayableDeck) IsExpired(now time.Time) bool {
+	return deck.ExpiresAt != nil && !now.Before(*deck.ExpiresAt)
+}
+
+// Clone returns a deep copy of the playable deck.
+// Clone allows a deck to be modified without altering the deck it was copied from.
+func (deck *PlayableDeck) Clone() *PlayableDeck {
+	clone := *deck
+	clone.Cards = cloneCards(deck.Cards)
+	clone.Drawn = cloneCards(deck.Drawn)
+	clone.Discarded = cloneCards(deck.Discarded)
+	clone.History = cloneHistory(deck.History)
+	if deck.Piles != nil {
+		clone.Piles = make(map[string][]cards.PlayingCard, len(deck.Piles))
+		for name, pile := range deck.Piles {
+			clone.Piles[name] = cloneCards(pile)
+		}
+	}
+	if deck.Seed != nil {
+		seed := *deck.Seed
+		clone.Seed = &seed
+	}
+	if deck.Fairness != nil {
+		clone.Fairness = deck.Fairness.clone()
+	}
+	if deck.ExpiresAt != nil {
+		expiresAt := *deck.ExpiresAt
+		clone.ExpiresAt = &expiresAt
+	}
+	return &clone
+}
+
+// cloneCards returns a copy of playingCards which does not share its underlying array.
+// cloneCards returns nil if playingCards is nil.
+func cloneCards(playingCards []cards.PlayingCard) []cards.PlayingCard {
+	if playingCards == nil {
+		return nil
+	}
+	clonedCards := make([]cards.PlayingCard, len(playingCards))
+	copy(clonedCards, playingCards)
+	return clonedCards
+}
+
 // CreateDeck creates a PlayableDeck based on the provided creationRequest and requestedCardCodes.
 // If requestedCardCodes is empty, a common PlayableDeck is created, according to the type of deck
 // standards.
-// CreateDeck can fail to create a PlayableDeck if the requested type is not handled.
+// If several decks are requested, the cards of every deck are combined into the PlayableDeck and
+// are marked with the index of the deck they originate from.
+// CreateDeck can fail to create a PlayableDeck if the requested type is not handled, or with
+// ErrInvalidCreationRequest if creationRequest is not valid.
 func CreateDeck(creationRequest CreationRequest, requestedCardCodes []string) (*PlayableDeck, error) {
-	var playingDeck PlayableDeck
-	switch creationRequest.PlayingType {
-	case cards.French:
-		deck, err := NewFrenchDeck(requestedCardCodes)
+	return createDeck(creationRequest, func() ([]cards.PlayingCard, error) {
+		return generatePlayingCards(creationRequest, requestedCardCodes)
+	})
+}
+
+// createDeck creates a PlayableDeck based on the provided creationRequest, out of the cards of a
+// single deck generated by generateDeckCards.
+// createDeck fails with ErrInvalidCreationRequest if creationRequest is not valid, or with the
+// error of generateDeckCards, if any.
+func createDeck(creationRequest CreationRequest, generateDeckCards func() ([]cards.PlayingCard, error)) (*PlayableDeck, error) {
+	if err := creationRequest.Validate(); err != nil {
+		return nil, err
+	}
+	deckCount := creationRequest.Count
+	if deckCount == 0 {
+		deckCount = 1
+	}
+	var playingCards []cards.PlayingCard
+	for deckIndex := 1; deckIndex <= deckCount; deckIndex++ {
+		deckCards, err := generateDeckCards()
 		if err != nil {
 			return nil, err
 		}
-		playingDeck = deck.PlayableDeck
-	default:
-		return nil, errors.New(fmt.Sprintf("unsupported operation for cards type '%s'", creationRequest.PlayingType.String()))
+		if deckCount > 1 {
+			markDeckIndex(deckCards, deckIndex)
+		}
+		playingCards = append(playingCards, deckCards...)
 	}
-	if creationRequest.Shuffled {
+	playingDeck := PlayableDeck{
+		ID:              uuid.New(),
+		Type:            creationRequest.PlayingType,
+		Variant:         creationRequest.Variant,
+		TemplateID:      creationRequest.TemplateID,
+		Cards:           playingCards,
+		Shuffled:        false,
+		ShuffleMode:     creationRequest.ShuffleMode,
+		ShuffleSequence: creationRequest.ShuffleSequence,
+		Remaining:       len(playingCards),
+	}
+	if creationRequest.ProvablyFair {
+		if err := playingDeck.ShuffleProvablyFair(creationRequest.ClientSeed); err != nil {
+			return nil, err
+		}
+	} else if creationRequest.Shuffled && creationRequest.Seed != nil {
+		playingDeck.ShuffleWithSeed(*creationRequest.Seed)
+	} else if creationRequest.Shuffled {
 		playingDeck.Shuffle()
 	}
+	playingDeck.StrictDraw = creationRequest.StrictDraw
+	playingDeck.TTL = creationRequest.TTL
 	return &playingDeck, nil
 }
+
+// validateVariant ensures variant is a supported variant of playingType.
+// The types of deck which are not supported are left to the generation of their cards.
+func validateVariant(playingType cards.PlayingCardType, variant string) error {
+	switch playingType {
+	case cards.French:
+		return validateFrenchVariant(variant)
+	case cards.Spanish:
+		return validateSpanishVariant(variant)
+	case cards.Italian:
+		return validateItalianVariant(variant)
+	case cards.German:
+		return validateGermanVariant(variant)
+	case cards.Tarot, cards.Uno, cards.Hanafuda, cards.Custom:
+		if variant != "" {
+			return fmt.Errorf("unsupported %s deck variant '%s'", strings.ToLower(playingType.String()), variant)
+		}
+	}
+	return nil
+}
+
+// markDeckIndex marks deckCards with deckIndex, the 1-based index of the deck deckCards originate
+// from among the combined decks.
+// The copies of a card within a single deck, e.g. in a Pinochle deck, keep their CopyIndex.
+func markDeckIndex(deckCards []cards.PlayingCard, deckIndex int) {
+	for i := range deckCards {
+		deckCards[i].DeckIndex = deckIndex
+	}
+}
+
+// generatePlayingCards generates and returns the cards of a single deck based on the provided
+// creationRequest and requestedCardCodes.
+// generatePlayingCards can fail if the requested type is not handled.
+func generatePlayingCards(creationRequest CreationRequest, requestedCardCodes []string) ([]cards.PlayingCard, error) {
+	switch creationRequest.PlayingType {
+	case cards.French:
+		options := []FrenchDeckOption{WithVariant(creationRequest.Variant)}
+		if creationRequest.Jokers {
+			options = append(options, WithJokers())
+		}
+		if len(creationRequest.Points) > 0 {
+			options = append(options, WithPoints(creationRequest.Points))
+		}
+		deck, err := NewFrenchDeck(requestedCardCodes, options...)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.Spanish:
+		deck, err := NewSpanishDeck(requestedCardCodes, creationRequest.Variant)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.Italian:
+		deck, err := NewItalianDeck(requestedCardCodes)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.German:
+		deck, err := NewGermanDeck(requestedCardCodes, creationRequest.Variant)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.Tarot:
+		deck, err := NewTarotDeck(requestedCardCodes)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.Uno:
+		deck, err := NewUnoDeck(requestedCardCodes)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	case cards.Hanafuda:
+		deck, err := NewHanafudaDeck(requestedCardCodes)
+		if err != nil {
+			return nil, err
+		}
+		return deck.Cards, nil
+	}
+	return nil, errors.New(fmt.Sprintf("unsupported operation for cards type '%s'", creationRequest.PlayingType.String()))
+}
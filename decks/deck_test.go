@@ -2,8 +2,10 @@ package decks
 
 import (
 	"croupier.io/cards"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestCreateDeckFromUndefinedType(t *testing.T) {
@@ -23,6 +25,15 @@ func TestCreateFrenchDeck(t *testing.T) {
 	assert.Equal(t, false, expectedDeck.Shuffled)
 }
 
+func TestCreateFrenchDeckWithJokers(t *testing.T) {
+	expectedDeck, _ := NewFrenchDeck([]string{}, WithJokers())
+
+	actualDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Jokers: true}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, expectedDeck.Cards, actualDeck.Cards)
+	assert.Equal(t, 54, actualDeck.Remaining)
+}
+
 func TestCreateFrenchDeckFailure(t *testing.T) {
 	savedFrenchCardSuits := cards.FrenchCardSuits
 	savedFrenchCardValues := cards.FrenchCardValues
@@ -39,6 +50,155 @@ func TestCreateFrenchDeckFailure(t *testing.T) {
 	})
 }
 
+func TestCreateShoe(t *testing.T) {
+	singleDeck, _ := NewFrenchDeck([]string{})
+
+	shoe, err := CreateDeck(CreationRequest{PlayingType: cards.French, Count: 6}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, 6*len(singleDeck.Cards), len(shoe.Cards))
+	assert.Equal(t, 6*len(singleDeck.Cards), shoe.Remaining)
+
+	type cardIdentity struct {
+		code      string
+		deckIndex int
+	}
+	cardOccurrences := make(map[cardIdentity]int)
+	for i, card := range shoe.Cards {
+		assert.Equal(t, i/len(singleDeck.Cards)+1, card.DeckIndex, "expected the cards to be traceable to their deck")
+		cardOccurrences[cardIdentity{code: card.Code, deckIndex: card.DeckIndex}] += 1
+		card.DeckIndex = 0
+		assert.Equal(t, singleDeck.Cards[i%len(singleDeck.Cards)], card, "expected the cards of a standard deck")
+	}
+	assert.Equal(t, len(shoe.Cards), len(cardOccurrences), "expected every card to be identifiable")
+}
+
+func TestCreatePinochleShoe(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Variant: FrenchPinochle, Count: 2}, []string{"AS"})
+	assert.Nil(t, err, "expected no error")
+	deckIndexes := make([]int, 0, len(playingDeck.Cards))
+	copyIndexes := make([]int, 0, len(playingDeck.Cards))
+	for _, card := range playingDeck.Cards {
+		deckIndexes = append(deckIndexes, card.DeckIndex)
+		copyIndexes = append(copyIndexes, card.CopyIndex)
+	}
+	assert.Equal(t, []int{1, 1, 2, 2}, deckIndexes, "expected the cards to be traceable to their deck")
+	assert.Equal(t, []int{1, 2, 1, 2}, copyIndexes, "expected the copies within every deck to be numbered")
+}
+
+func TestCreatePartialShoe(t *testing.T) {
+	shoe, err := CreateDeck(CreationRequest{PlayingType: cards.French, Count: 2}, []string{"AS", "KH"})
+	assert.Nil(t, err, "expected no error")
+	aceMetadata := &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}
+	kingMetadata := &cards.CardMetadata{Rank: 13, Color: "RED", Face: true, Points: 10}
+	assert.Equal(t, []cards.PlayingCard{
+		{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", DeckIndex: 1, Metadata: aceMetadata},
+		{Suit: cards.Hearts.String(), Value: "KING", Code: "KH", DeckIndex: 1, Metadata: kingMetadata},
+		{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", DeckIndex: 2, Metadata: aceMetadata},
+		{Suit: cards.Hearts.String(), Value: "KING", Code: "KH", DeckIndex: 2, Metadata: kingMetadata},
+	}, shoe.Cards)
+}
+
+func TestCreateDeckWithInvalidCount(t *testing.T) {
+	for _, count := range []int{-1, MaxDeckCount + 1} {
+		playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Count: count}, nil)
+		assert.Nil(t, playingDeck, "expected no deck")
+		assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+		assert.Contains(t, err.Error(), fmt.Sprintf("between 0 and %d", MaxDeckCount), "expected the accepted counts")
+	}
+	for _, count := range []int{0, 1} {
+		playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Count: count}, nil)
+		assert.Nil(t, err, "expected no error for a count of %d", count)
+		assert.Equal(t, 52, playingDeck.Remaining, "expected a single deck for a count of %d", count)
+	}
+}
+
+func TestValidateCreationRequest(t *testing.T) {
+	seed := int64(42)
+	testRecords := []struct {
+		creationRequest CreationRequest
+		expectedValid   bool
+	}{
+		{CreationRequest{}, true},
+		{CreationRequest{Count: MaxDeckCount, TTL: 60, Seed: &seed}, true},
+		{CreationRequest{ShuffleMode: SeededShuffle, Seed: &seed}, true},
+		{CreationRequest{ShuffleMode: SecureShuffle}, true},
+		{CreationRequest{Count: -1}, false},
+		{CreationRequest{Count: MaxDeckCount + 1}, false},
+		{CreationRequest{TTL: -1}, false},
+		{CreationRequest{ShuffleMode: SecureShuffle, Seed: &seed}, false},
+		{CreationRequest{ShuffleMode: "lucky"}, false},
+		{CreationRequest{Shuffled: true, ProvablyFair: true, ClientSeed: "lucky"}, true},
+		{CreationRequest{ProvablyFair: true}, false},
+		{CreationRequest{Shuffled: true, ProvablyFair: true, Seed: &seed}, false},
+		{CreationRequest{Shuffled: true, ProvablyFair: true, ShuffleMode: SecureShuffle}, false},
+		{CreationRequest{Shuffled: true, ClientSeed: "lucky"}, false},
+		{CreationRequest{Variant: FrenchPinochle}, true},
+		{CreationRequest{Variant: "bridge"}, false},
+		{CreationRequest{Shuffled: true, ShuffleSequence: "riffle x7, cut"}, true},
+		{CreationRequest{ShuffleSequence: "riffle x7, cut"}, false},
+		{CreationRequest{Shuffled: true, ShuffleSequence: "shake"}, false},
+		{CreationRequest{PlayingType: cards.Custom, TemplateID: "a1b2"}, true},
+		{CreationRequest{PlayingType: cards.Custom}, false},
+		{CreationRequest{TemplateID: "a1b2"}, false},
+		{CreationRequest{PlayingType: cards.Custom, TemplateID: "a1b2", Variant: "40"}, false},
+		{CreationRequest{Points: cards.FrenchCardPoints{"ACE": 11, "QS": 13, "JOKER": 50}}, true},
+		{CreationRequest{Points: cards.FrenchCardPoints{"KNIGHT": 3}}, false},
+		{CreationRequest{PlayingType: cards.Tarot, Points: cards.FrenchCardPoints{"ACE": 11}}, false},
+		{CreationRequest{Jokers: true}, true},
+		{CreationRequest{Variant: FrenchEuchre, Jokers: true}, true},
+		{CreationRequest{PlayingType: cards.Spanish, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.Italian, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.German, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.Tarot, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.Uno, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.Hanafuda, Jokers: true}, false},
+		{CreationRequest{PlayingType: cards.Custom, TemplateID: "a1b2", Jokers: true}, false},
+	}
+	for _, testRecord := range testRecords {
+		err := testRecord.creationRequest.Validate()
+		if testRecord.expectedValid {
+			assert.Nil(t, err, "expected a valid request")
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+		}
+	}
+}
+
+func TestCreateDeckWithTTL(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, TTL: 60}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, 60, playingDeck.TTL)
+	assert.Nil(t, playingDeck.ExpiresAt, "expected no expiry before the deck is touched")
+
+	playingDeck, err = CreateDeck(CreationRequest{PlayingType: cards.French, TTL: -1}, nil)
+	assert.Nil(t, playingDeck, "expected no deck")
+	assert.NotNil(t, err, "expected an error")
+}
+
+func TestCreateDeckWithStrictDraw(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, StrictDraw: true}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.True(t, playingDeck.StrictDraw, "expected a strict draw policy")
+}
+
+func TestTouch(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	playingDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French}, nil)
+	playingDeck.Touch(now)
+	assert.Nil(t, playingDeck.ExpiresAt, "expected a deck without TTL to never expire")
+	assert.False(t, playingDeck.IsExpired(now.Add(24*time.Hour)))
+
+	playingDeck, _ = CreateDeck(CreationRequest{PlayingType: cards.French, TTL: 60}, nil)
+	playingDeck.Touch(now)
+	assert.Equal(t, now.Add(time.Minute), *playingDeck.ExpiresAt)
+	assert.False(t, playingDeck.IsExpired(now.Add(59*time.Second)))
+	assert.True(t, playingDeck.IsExpired(now.Add(time.Minute)))
+
+	playingDeck.Touch(now.Add(30 * time.Second))
+	assert.False(t, playingDeck.IsExpired(now.Add(time.Minute)), "expected the expiry to be postponed")
+}
+
 func TestCreateShuffledDeck(t *testing.T) {
 	sortedDeck, _ := NewFrenchDeck([]string{})
 
@@ -52,11 +212,37 @@ func TestCreateShuffledDeck(t *testing.T) {
 	assert.Equal(t, len(sortedDeck.PlayableDeck.Cards), len(shuffledDeck.Cards))
 }
 
+func TestCreateSeededDeck(t *testing.T) {
+	seed := int64(42)
+
+	firstDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, Seed: &seed}, nil)
+	assert.Nil(t, err, "expected no error")
+	secondDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, Seed: &seed}, nil)
+	assert.Nil(t, err, "expected no error")
+
+	assert.Equal(t, firstDeck.Cards, secondDeck.Cards, "expected identical orders out of the same seed")
+	assert.Equal(t, seed, *firstDeck.Seed)
+	assert.True(t, firstDeck.Shuffled, "expected a shuffled deck")
+}
+
+func TestShuffleRecordsSeed(t *testing.T) {
+	sortedDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French}, nil)
+	assert.Nil(t, sortedDeck.Seed, "expected no seed for an unshuffled deck")
+
+	shuffledDeck := sortedDeck.Clone()
+	shuffledDeck.Shuffle()
+	assert.NotNil(t, shuffledDeck.Seed, "expected the seed of the shuffle")
+
+	replayedDeck := sortedDeck.Clone()
+	replayedDeck.ShuffleWithSeed(*shuffledDeck.Seed)
+	assert.Equal(t, shuffledDeck.Cards, replayedDeck.Cards, "expected the shuffle to be replayed")
+}
+
 func TestDrawCard(t *testing.T) {
 	requestedCardCodes := []string{"AS", "2S", "3S"}
-	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS"}
-	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S"}
-	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S"}
+	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", Metadata: &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}}
+	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S", Metadata: &cards.CardMetadata{Rank: 2, Color: "BLACK", Points: 2}}
+	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S", Metadata: &cards.CardMetadata{Rank: 3, Color: "BLACK", Points: 3}}
 
 	testRecords := []struct {
 		requestedDrawnCardNumber  int
@@ -93,3 +279,19 @@ func TestDrawCard(t *testing.T) {
 		assert.Equal(t, len(testRecord.expectedRemainingCards), playingDeck.Remaining, "wrong remaining count")
 	}
 }
+
+func TestClone(t *testing.T) {
+	playingDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French}, []string{"AS", "2S", "3S"})
+
+	playingDeck.TTL = 60
+	playingDeck.Touch(time.Now())
+
+	clonedDeck := playingDeck.Clone()
+	assert.Equal(t, playingDeck, clonedDeck, "expected identical decks")
+
+	clonedDeck.Cards[0] = cards.PlayingCard{Suit: cards.Hearts.String(), Value: "KING", Code: "KH"}
+	clonedDeck.DrawCard(1)
+	assert.Equal(t, "AS", playingDeck.Cards[0].Code, "expected the original cards to be untouched")
+	assert.Equal(t, 3, playingDeck.Remaining, "expected the original remaining count to be untouched")
+	assert.NotSame(t, playingDeck.ExpiresAt, clonedDeck.ExpiresAt, "expected distinct expiry times")
+}
@@ -0,0 +1,181 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrCardUnavailable is returned when a requested card is not available where it is expected in a deck.
+var ErrCardUnavailable = errors.New("card unavailable")
+
+// ReturnPosition is the representation of the position at which cards are returned in a deck.
+type ReturnPosition string
+
+const (
+	Top    ReturnPosition = "top"
+	Bottom ReturnPosition = "bottom"
+	Random ReturnPosition = "random"
+)
+
+// ParseReturnPosition returns the ReturnPosition associated with position.
+// ParseReturnPosition returns Top if position is empty.
+// A successful ParseReturnPosition returns err == nil.
+func ParseReturnPosition(position string) (ReturnPosition, error) {
+	switch ReturnPosition(position) {
+	case "", Top:
+		return Top, nil
+	case Bottom, Random:
+		return ReturnPosition(position), nil
+	}
+	return "", errors.New(fmt.Sprintf("unsupported return position '%s'", position))
+}
+
+// Discard moves the cards associated with cardCodes from the drawn cards to the discard pile.
+// Discard returns the discarded cards.
+// Discard fails with ErrCardUnavailable, without discarding any card, if any of the cards has not
+// been drawn from the deck.
+func (deck *PlayableDeck) Discard(cardCodes []string) ([]cards.PlayingCard, error) {
+	cardCodes, err := deck.parseCardCodes(cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	discardedCards, drawnCards, err := takeCards(deck.Drawn, cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	deck.Drawn = drawnCards
+	deck.Discarded = append(deck.Discarded, discardedCards...)
+	return discardedCards, nil
+}
+
+// Return puts the cards associated with cardCodes back in the deck at position.
+// The cards are looked up in the drawn cards first, then in the discard pile.
+// The random positions are picked with the random generator of the ShuffleMode, whose seed, if any,
+// is recorded in History.
+// Return keeps track of Remaining and returns the returned cards.
+// Return fails with ErrCardUnavailable, without returning any card, if any of the cards has
+// neither been drawn nor discarded from the deck.
+func (deck *PlayableDeck) Return(cardCodes []string, position ReturnPosition) ([]cards.PlayingCard, error) {
+	cardCodes, err := deck.parseCardCodes(cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	drawnCards := cloneCards(deck.Drawn)
+	discardedCards := cloneCards(deck.Discarded)
+	returnedCards := make([]cards.PlayingCard, 0, len(cardCodes))
+	for _, cardCode := range cardCodes {
+		if index := indexOfCard(drawnCards, cardCode); index >= 0 {
+			returnedCards = append(returnedCards, drawnCards[index])
+			drawnCards = append(drawnCards[:index], drawnCards[index+1:]...)
+		} else if index := indexOfCard(discardedCards, cardCode); index >= 0 {
+			returnedCards = append(returnedCards, discardedCards[index])
+			discardedCards = append(discardedCards[:index], discardedCards[index+1:]...)
+		} else {
+			return nil, fmt.Errorf("%w: '%s'", ErrCardUnavailable, cardCode)
+		}
+	}
+	deck.Drawn = drawnCards
+	deck.Discarded = discardedCards
+
+	switch position {
+	case Bottom:
+		deck.Cards = append(deck.Cards, returnedCards...)
+	case Random:
+		random := deck.randomSource(RandomReturnOperation)
+		for _, card := range returnedCards {
+			index := random.Intn(len(deck.Cards) + 1)
+			deck.Cards = append(deck.Cards[:index], append([]cards.PlayingCard{card}, deck.Cards[index:]...)...)
+		}
+	default:
+		deck.Cards = append(cloneCards(returnedCards), deck.Cards...)
+	}
+	deck.Remaining = len(deck.Cards)
+	return returnedCards, nil
+}
+
+// Gather puts every card drawn, discarded or added to a pile from a playable deck back at the
+// bottom of the deck, in this order; the piles are gathered by name.
+// Gather empties Drawn, Discarded and Piles, keeps track of Remaining and records the operation in
+// History.
+func (deck *PlayableDeck) Gather() {
+	deck.Cards = append(deck.Cards, deck.Drawn...)
+	deck.Cards = append(deck.Cards, deck.Discarded...)
+	pileNames := make([]string, 0, len(deck.Piles))
+	for pileName := range deck.Piles {
+		pileNames = append(pileNames, pileName)
+	}
+	sort.Strings(pileNames)
+	for _, pileName := range pileNames {
+		deck.Cards = append(deck.Cards, deck.Piles[pileName]...)
+	}
+	deck.Drawn = make([]cards.PlayingCard, 0)
+	deck.Discarded = make([]cards.PlayingCard, 0)
+	deck.Piles = nil
+	deck.Remaining = len(deck.Cards)
+	deck.record(Operation{Type: GatherOperation})
+}
+
+// ReshuffleDiscarded moves the discard pile back in the deck and shuffles the cards contained in
+// the deck like Shuffle, according to its ShuffleMode.
+func (deck *PlayableDeck) ReshuffleDiscarded() {
+	deck.returnDiscarded()
+	deck.Shuffle()
+}
+
+// ReshuffleDiscardedWithSeed moves the discard pile back in the deck and shuffles the cards
+// contained in the deck with seed.
+// ReshuffleDiscardedWithSeed only applies to a SeededShuffle.
+func (deck *PlayableDeck) ReshuffleDiscardedWithSeed(seed int64) {
+	deck.returnDiscarded()
+	deck.ShuffleWithSeed(seed)
+}
+
+// returnDiscarded moves the discard pile back at the bottom of the deck.
+// returnDiscarded keeps track of Remaining.
+func (deck *PlayableDeck) returnDiscarded() {
+	deck.Cards = append(deck.Cards, deck.Discarded...)
+	deck.Discarded = make([]cards.PlayingCard, 0)
+	deck.Remaining = len(deck.Cards)
+}
+
+// parseCardCodes returns the canonical version of cardCodes, parsed with cards.ParseCodes if the
+// deck is a French deck, so that the cards can be requested with case-insensitive codes or aliases.
+// The card codes of the other types of deck are returned as they are.
+// parseCardCodes fails with cards.CodeErrors if any of the card codes of a French deck cannot be
+// parsed.
+func (deck *PlayableDeck) parseCardCodes(cardCodes []string) ([]string, error) {
+	if deck.Type != cards.French {
+		return cardCodes, nil
+	}
+	return cards.ParseCodes(cardCodes)
+}
+
+// takeCards takes the cards associated with cardCodes from pile.
+// takeCards returns the taken cards and the cards remaining in pile, without modifying pile.
+// takeCards fails with ErrCardUnavailable if any of the cards is not contained in pile.
+func takeCards(pile []cards.PlayingCard, cardCodes []string) (taken []cards.PlayingCard, rest []cards.PlayingCard, err error) {
+	rest = cloneCards(pile)
+	taken = make([]cards.PlayingCard, 0, len(cardCodes))
+	for _, cardCode := range cardCodes {
+		index := indexOfCard(rest, cardCode)
+		if index < 0 {
+			return nil, nil, fmt.Errorf("%w: '%s'", ErrCardUnavailable, cardCode)
+		}
+		taken = append(taken, rest[index])
+		rest = append(rest[:index], rest[index+1:]...)
+	}
+	return taken, rest, nil
+}
+
+// indexOfCard returns the index of the first card associated with cardCode in pile, or -1 if
+// cardCode is not present in pile.
+func indexOfCard(pile []cards.PlayingCard, cardCode string) int {
+	for i, card := range pile {
+		if card.Code == cardCode {
+			return i
+		}
+	}
+	return -1
+}
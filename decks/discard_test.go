@@ -0,0 +1,232 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var (
+	aceOfSpades   = cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", Metadata: &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}}
+	twoOfSpades   = cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S", Metadata: &cards.CardMetadata{Rank: 2, Color: "BLACK", Points: 2}}
+	threeOfSpades = cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S", Metadata: &cards.CardMetadata{Rank: 3, Color: "BLACK", Points: 3}}
+	fourOfSpades  = cards.PlayingCard{Suit: cards.Spades.String(), Value: "4", Code: "4S", Metadata: &cards.CardMetadata{Rank: 4, Color: "BLACK", Points: 4}}
+)
+
+func TestParseReturnPosition(t *testing.T) {
+	testRecords := []struct {
+		position         string
+		expectedPosition ReturnPosition
+	}{
+		{"", Top},
+		{"top", Top},
+		{"bottom", Bottom},
+		{"random", Random},
+		{"middle", ""},
+	}
+	for _, testRecord := range testRecords {
+		position, err := ParseReturnPosition(testRecord.position)
+		assert.Equal(t, testRecord.expectedPosition, position)
+		if testRecord.expectedPosition == "" {
+			assert.NotNil(t, err, "expected an error")
+		} else {
+			assert.Nil(t, err, "expected no error")
+		}
+	}
+}
+
+func TestDrawCardKeepsTrackOfDrawnCards(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	playingDeck.DrawCard(1)
+	playingDeck.DrawCard(2)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}, playingDeck.Drawn)
+}
+
+func TestDiscard(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(3)
+
+	discardedCards, err := playingDeck.Discard([]string{"3S", "AS"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, discardedCards)
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, playingDeck.Drawn)
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, playingDeck.Discarded)
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, playingDeck.Cards)
+}
+
+func TestDiscardUnavailableCard(t *testing.T) {
+	testRecords := [][]string{
+		{"4S"},
+		{"KH"},
+		{"AS", "AS"},
+	}
+	for _, cardCodes := range testRecords {
+		playingDeck := newDiscardTestDeck(t)
+		playingDeck.DrawCard(3)
+		expectedDeck := playingDeck.Clone()
+
+		discardedCards, err := playingDeck.Discard(cardCodes)
+		assert.Nil(t, discardedCards, "expected no discarded cards")
+		assert.ErrorIs(t, err, ErrCardUnavailable)
+		assert.Equal(t, expectedDeck, playingDeck, "expected the deck to be untouched")
+	}
+}
+
+func TestDiscardWithCardCodeAliases(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(3)
+
+	discardedCards, err := playingDeck.Discard([]string{"3s", "ace of spades"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, discardedCards, "expected the aliased cards to be discarded")
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, playingDeck.Drawn)
+}
+
+func TestDiscardInvalidCardCode(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(3)
+	expectedDeck := playingDeck.Clone()
+
+	discardedCards, err := playingDeck.Discard([]string{"AS", "X", "1Z"})
+	assert.Nil(t, discardedCards, "expected no discarded cards")
+	assert.ErrorIs(t, err, cards.ErrInvalidCode)
+	var codeErrors cards.CodeErrors
+	assert.ErrorAs(t, err, &codeErrors)
+	assert.Len(t, codeErrors, 2, "expected an error per invalid card code")
+	assert.Equal(t, expectedDeck, playingDeck, "expected the deck to be untouched")
+}
+
+func TestReturn(t *testing.T) {
+	testRecords := []struct {
+		position      ReturnPosition
+		expectedCards []cards.PlayingCard
+	}{
+		{Top, []cards.PlayingCard{threeOfSpades, aceOfSpades, fourOfSpades}},
+		{Bottom, []cards.PlayingCard{fourOfSpades, threeOfSpades, aceOfSpades}},
+	}
+	for _, testRecord := range testRecords {
+		playingDeck := newDiscardTestDeck(t)
+		playingDeck.DrawCard(3)
+		_, _ = playingDeck.Discard([]string{"AS"})
+
+		returnedCards, err := playingDeck.Return([]string{"3S", "AS"}, testRecord.position)
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, returnedCards)
+		assert.Equal(t, testRecord.expectedCards, playingDeck.Cards)
+		assert.Equal(t, 3, playingDeck.Remaining)
+		assert.Equal(t, []cards.PlayingCard{twoOfSpades}, playingDeck.Drawn)
+		assert.Empty(t, playingDeck.Discarded, "expected an empty discard pile")
+	}
+}
+
+func TestReturnAtRandomPosition(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(2)
+
+	returnedCards, err := playingDeck.Return([]string{"AS", "2S"}, Random)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, returnedCards)
+	assert.ElementsMatch(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 4, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Drawn, "expected no drawn cards")
+}
+
+func TestReturnAtRandomPositionIsReplayable(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(2)
+	replayedCards := cloneCards(playingDeck.Cards)
+
+	_, err := playingDeck.Return([]string{"AS", "2S"}, Random)
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, playingDeck.History, 1, "expected the random return to be recorded")
+	operation := playingDeck.History[0]
+	assert.Equal(t, RandomReturnOperation, operation.Type)
+	if assert.NotNil(t, operation.Seed, "expected the seed of the random return") {
+		source := newSeededRandomSource(*operation.Seed)
+		for _, card := range []cards.PlayingCard{aceOfSpades, twoOfSpades} {
+			index := source.Intn(len(replayedCards) + 1)
+			replayedCards = append(replayedCards[:index], append([]cards.PlayingCard{card}, replayedCards[index:]...)...)
+		}
+		assert.Equal(t, replayedCards, playingDeck.Cards, "expected the recorded seed to replay the return")
+	}
+}
+
+func TestReturnUnavailableCard(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(1)
+	expectedDeck := playingDeck.Clone()
+
+	returnedCards, err := playingDeck.Return([]string{"AS", "2S"}, Top)
+	assert.Nil(t, returnedCards, "expected no returned cards")
+	assert.ErrorIs(t, err, ErrCardUnavailable)
+	assert.Equal(t, expectedDeck, playingDeck, "expected the deck to be untouched")
+}
+
+func TestReshuffleDiscarded(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(3)
+	_, _ = playingDeck.Discard([]string{"AS", "2S"})
+
+	playingDeck.ReshuffleDiscarded()
+	assert.ElementsMatch(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, fourOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 3, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Discarded, "expected an empty discard pile")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades}, playingDeck.Drawn)
+	assert.True(t, playingDeck.Shuffled, "expected a shuffled deck")
+}
+
+func TestReshuffleDiscardedSecurely(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.ShuffleMode = SecureShuffle
+	playingDeck.DrawCard(3)
+	_, _ = playingDeck.Discard([]string{"AS", "2S"})
+
+	playingDeck.ReshuffleDiscarded()
+	assert.ElementsMatch(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, fourOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 3, playingDeck.Remaining)
+	assert.True(t, playingDeck.Shuffled, "expected a shuffled deck")
+	assert.Nil(t, playingDeck.Seed, "expected no seed for a secure deck")
+	if assert.Len(t, playingDeck.History, 1, "expected the shuffle to be recorded") {
+		assert.Equal(t, ShuffleOperation, playingDeck.History[0].Type)
+		assert.Nil(t, playingDeck.History[0].Seed, "expected no seed to be recorded for a secure deck")
+	}
+}
+
+func TestReshuffleDiscardedWithSeed(t *testing.T) {
+	var shuffledDecks []*PlayableDeck
+	for i := 0; i < 2; i++ {
+		playingDeck := newDiscardTestDeck(t)
+		playingDeck.DrawCard(3)
+		_, _ = playingDeck.Discard([]string{"AS", "2S", "3S"})
+
+		playingDeck.ReshuffleDiscardedWithSeed(7)
+		assert.Equal(t, int64(7), *playingDeck.Seed)
+		shuffledDecks = append(shuffledDecks, playingDeck)
+	}
+	assert.Equal(t, shuffledDecks[0].Cards, shuffledDecks[1].Cards, "expected identical orders out of the same seed")
+}
+
+func TestGather(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(1)
+	_, _ = playingDeck.DrawToPile("player2", 1)
+	_, _ = playingDeck.DrawToPile("player1", 1)
+	_, _ = playingDeck.Discard([]string{"AS"})
+
+	playingDeck.Gather()
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades, aceOfSpades, threeOfSpades, twoOfSpades}, playingDeck.Cards, "expected the cards to be gathered at the bottom of the deck")
+	assert.Equal(t, 4, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Drawn, "expected no drawn card")
+	assert.Empty(t, playingDeck.Discarded, "expected an empty discard pile")
+	assert.Empty(t, playingDeck.Piles, "expected no pile")
+	assert.Equal(t, []Operation{{Type: GatherOperation}}, playingDeck.History, "expected the gathering to be recorded")
+}
+
+func newDiscardTestDeck(t *testing.T) *PlayableDeck {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French}, []string{"AS", "2S", "3S", "4S"})
+	if err != nil {
+		t.Fatalf("unable to create the test deck: %s", err)
+	}
+	return playingDeck
+}
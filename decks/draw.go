@@ -0,0 +1,169 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+)
+
+// ErrCardNotFound is returned when a requested card is not part of a deck at all.
+var ErrCardNotFound = errors.New("card not found")
+
+// ErrInvalidDrawCount is returned when a strict draw requests a non-positive number of cards.
+var ErrInvalidDrawCount = errors.New("invalid draw count")
+
+// ErrInsufficientCards is returned when a strict draw requests more cards than remain in a deck.
+var ErrInsufficientCards = errors.New("insufficient cards")
+
+// InsufficientCardsError is the error returned when a strict draw requests Requested cards while
+// only Remaining cards remain in a deck.
+// InsufficientCardsError wraps ErrInsufficientCards.
+type InsufficientCardsError struct {
+	Requested int
+	Remaining int
+}
+
+// Error returns the description of the error.
+func (err *InsufficientCardsError) Error() string {
+	return fmt.Sprintf("%s: %d cards requested, %d remaining", ErrInsufficientCards.Error(), err.Requested, err.Remaining)
+}
+
+// Unwrap returns ErrInsufficientCards.
+func (err *InsufficientCardsError) Unwrap() error {
+	return ErrInsufficientCards
+}
+
+// DrawPosition is the representation of the position from which cards are drawn from a deck.
+type DrawPosition string
+
+const (
+	DrawTop    DrawPosition = "top"
+	DrawBottom DrawPosition = "bottom"
+	DrawRandom DrawPosition = "random"
+)
+
+// ParseDrawPosition returns the DrawPosition associated with position.
+// ParseDrawPosition returns DrawTop if position is empty.
+// A successful ParseDrawPosition returns err == nil.
+func ParseDrawPosition(position string) (DrawPosition, error) {
+	switch DrawPosition(position) {
+	case "", DrawTop:
+		return DrawTop, nil
+	case DrawBottom, DrawRandom:
+		return DrawPosition(position), nil
+	}
+	return "", errors.New(fmt.Sprintf("unsupported draw position '%s'", position))
+}
+
+// Peek returns a copy of a specific number of cards from the top of the cards contained in a
+// deck, if any, without drawing them.
+func (deck *PlayableDeck) Peek(requestedPeekCardCount int) []cards.PlayingCard {
+	if requestedPeekCardCount < 0 {
+		requestedPeekCardCount = 0
+	}
+	if requestedPeekCardCount > len(deck.Cards) {
+		requestedPeekCardCount = len(deck.Cards)
+	}
+	return cloneCards(deck.Cards[:requestedPeekCardCount])
+}
+
+// DrawCardFrom pulls a specific number of cards from position in the cards contained in a deck,
+// if any.
+// The cards drawn from the bottom are returned starting with the bottom card, and the cards drawn
+// at random are picked from the remaining cards with the random generator of the ShuffleMode, whose
+// seed, if any, is recorded in History.
+// The cards that are drawn are removed from the deck, are kept track of in Drawn and are returned.
+// DrawCardFrom keeps track of Remaining.
+func (deck *PlayableDeck) DrawCardFrom(requestedDrawCardCount int, position DrawPosition) []cards.PlayingCard {
+	var playingCards []cards.PlayingCard
+	switch position {
+	case DrawBottom:
+		playingCards = deck.drawCardsWith(requestedDrawCardCount, func(remaining int) int { return remaining - 1 })
+	case DrawRandom:
+		source := deck.randomSource(RandomDrawOperation)
+		playingCards = deck.drawCardsWith(requestedDrawCardCount, source.Intn)
+	default:
+		playingCards = deck.drawCards(requestedDrawCardCount)
+	}
+	deck.Drawn = append(deck.Drawn, playingCards...)
+	return playingCards
+}
+
+// DrawCardStrictly pulls exactly a specific number of cards from position in the cards contained
+// in a deck, like DrawCardFrom.
+// DrawCardStrictly fails, without drawing any card, with ErrInvalidDrawCount if
+// requestedDrawCardCount is not positive, or with an InsufficientCardsError if fewer cards remain
+// in the deck.
+func (deck *PlayableDeck) DrawCardStrictly(requestedDrawCardCount int, position DrawPosition) ([]cards.PlayingCard, error) {
+	if err := deck.checkStrictDrawCount(requestedDrawCardCount); err != nil {
+		return nil, err
+	}
+	return deck.DrawCardFrom(requestedDrawCardCount, position), nil
+}
+
+// checkStrictDrawCount ensures exactly requestedDrawCardCount cards can be drawn from a deck.
+// checkStrictDrawCount fails with ErrInvalidDrawCount if requestedDrawCardCount is not positive, or
+// with an InsufficientCardsError if fewer cards remain in the deck.
+func (deck *PlayableDeck) checkStrictDrawCount(requestedDrawCardCount int) error {
+	if requestedDrawCardCount <= 0 {
+		return fmt.Errorf("%w: the number of cards to draw must be positive", ErrInvalidDrawCount)
+	}
+	if requestedDrawCardCount > len(deck.Cards) {
+		return &InsufficientCardsError{Requested: requestedDrawCardCount, Remaining: len(deck.Cards)}
+	}
+	return nil
+}
+
+// DrawCardByCodes pulls the cards associated with cardCodes from the cards contained in a deck.
+// The cards that are drawn are removed from the deck, are kept track of in Drawn and are returned.
+// DrawCardByCodes keeps track of Remaining.
+// DrawCardByCodes fails, without drawing any card, with ErrCardNotFound if any of the cards is not
+// part of the deck, or with ErrCardUnavailable if any of the cards is not remaining in the deck.
+func (deck *PlayableDeck) DrawCardByCodes(cardCodes []string) ([]cards.PlayingCard, error) {
+	cardCodes, err := deck.parseCardCodes(cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	drawnCards, remainingCards, err := takeCards(deck.Cards, cardCodes)
+	if err != nil {
+		for _, cardCode := range cardCodes {
+			if !deck.contains(cardCode) {
+				return nil, fmt.Errorf("%w: '%s'", ErrCardNotFound, cardCode)
+			}
+		}
+		return nil, err
+	}
+	deck.Cards = remainingCards
+	deck.Remaining = len(deck.Cards)
+	deck.Drawn = append(deck.Drawn, drawnCards...)
+	return drawnCards, nil
+}
+
+// drawCardsWith pulls a specific number of cards from the cards contained in a deck, if any,
+// picking every card at the index returned by pick out of the number of remaining cards.
+// The cards that are drawn are removed from the deck and are returned.
+// drawCardsWith keeps track of Remaining.
+func (deck *PlayableDeck) drawCardsWith(requestedDrawCardCount int, pick func(remaining int) int) []cards.PlayingCard {
+	playingCards := make([]cards.PlayingCard, 0)
+	for i := 0; i < requestedDrawCardCount && len(deck.Cards) > 0; i++ {
+		index := pick(len(deck.Cards))
+		playingCards = append(playingCards, deck.Cards[index])
+		deck.Cards = append(deck.Cards[:index], deck.Cards[index+1:]...)
+		deck.Remaining -= 1
+	}
+	return playingCards
+}
+
+// contains returns true if the card associated with cardCode is part of a deck, whether it is
+// remaining in the deck, drawn, discarded or added to a pile.
+func (deck *PlayableDeck) contains(cardCode string) bool {
+	if indexOfCard(deck.Cards, cardCode) >= 0 || indexOfCard(deck.Drawn, cardCode) >= 0 || indexOfCard(deck.Discarded, cardCode) >= 0 {
+		return true
+	}
+	for _, pile := range deck.Piles {
+		if indexOfCard(pile, cardCode) >= 0 {
+			return true
+		}
+	}
+	return false
+}
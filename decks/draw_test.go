@@ -0,0 +1,146 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseDrawPosition(t *testing.T) {
+	testRecords := []struct {
+		position         string
+		expectedPosition DrawPosition
+	}{
+		{"", DrawTop},
+		{"top", DrawTop},
+		{"bottom", DrawBottom},
+		{"random", DrawRandom},
+		{"middle", ""},
+	}
+	for _, testRecord := range testRecords {
+		position, err := ParseDrawPosition(testRecord.position)
+		assert.Equal(t, testRecord.expectedPosition, position)
+		if testRecord.expectedPosition == "" {
+			assert.NotNil(t, err, "expected an error")
+		} else {
+			assert.Nil(t, err, "expected no error")
+		}
+	}
+}
+
+func TestPeek(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	peekedCards := playingDeck.Peek(2)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, peekedCards)
+	assert.Equal(t, 4, playingDeck.Remaining, "expected no card to be drawn")
+	assert.Len(t, playingDeck.Cards, 4, "expected no card to be drawn")
+	assert.Empty(t, playingDeck.Drawn, "expected no card to be drawn")
+
+	peekedCards[0] = fourOfSpades
+	assert.Equal(t, aceOfSpades, playingDeck.Cards[0], "expected the peeked cards to be a copy")
+
+	assert.Len(t, playingDeck.Peek(10), 4, "expected every card to be peeked at")
+	assert.Empty(t, playingDeck.Peek(-1), "expected no card to be peeked at")
+}
+
+func TestDrawCardFromBottom(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	drawnCards := playingDeck.DrawCardFrom(2, DrawBottom)
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades, threeOfSpades}, drawnCards, "expected the bottom cards first")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 2, playingDeck.Remaining)
+	assert.Equal(t, drawnCards, playingDeck.Drawn, "expected the drawn cards to be tracked")
+
+	assert.Len(t, playingDeck.DrawCardFrom(5, DrawBottom), 2, "expected the draw to be limited to the remaining cards")
+	assert.Equal(t, 0, playingDeck.Remaining)
+}
+
+func TestDrawCardAtRandom(t *testing.T) {
+	for _, shuffleMode := range []ShuffleMode{SeededShuffle, SecureShuffle} {
+		playingDeck := newDiscardTestDeck(t)
+		playingDeck.ShuffleMode = shuffleMode
+
+		drawnCards := playingDeck.DrawCardFrom(3, DrawRandom)
+		assert.Len(t, drawnCards, 3)
+		assert.Equal(t, 1, playingDeck.Remaining)
+		assert.ElementsMatch(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}, append(drawnCards, playingDeck.Cards...), "expected the same cards")
+		assert.Equal(t, drawnCards, playingDeck.Drawn, "expected the drawn cards to be tracked")
+	}
+}
+
+func TestDrawCardAtRandomIsReplayable(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	replayedDeck := playingDeck.Clone()
+
+	drawnCards := playingDeck.DrawCardFrom(3, DrawRandom)
+	assert.Len(t, playingDeck.History, 1, "expected the random draw to be recorded")
+	operation := playingDeck.History[0]
+	assert.Equal(t, RandomDrawOperation, operation.Type)
+	if assert.NotNil(t, operation.Seed, "expected the seed of the random draw") {
+		source := newSeededRandomSource(*operation.Seed)
+		assert.Equal(t, drawnCards, replayedDeck.drawCardsWith(3, source.Intn), "expected the recorded seed to replay the draw")
+	}
+
+	playingDeck = newDiscardTestDeck(t)
+	playingDeck.ShuffleMode = SecureShuffle
+	playingDeck.DrawCardFrom(3, DrawRandom)
+	assert.Empty(t, playingDeck.History, "expected no seed for a secure deck")
+}
+
+func TestDrawCardAtRandomIsUniform(t *testing.T) {
+	drawCounts := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		playingDeck := newDiscardTestDeck(t)
+		drawCounts[playingDeck.DrawCardFrom(1, DrawRandom)[0].Code] += 1
+	}
+	for code, count := range drawCounts {
+		assert.InDelta(t, 1000, count, 150, "expected '%s' to be drawn a quarter of the time", code)
+	}
+	assert.Len(t, drawCounts, 4, "expected every card to be drawn")
+}
+
+func TestDrawCardByCodes(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(1)
+
+	drawnCards, err := playingDeck.DrawCardByCodes([]string{"3S", "2S"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, twoOfSpades}, drawnCards)
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 1, playingDeck.Remaining)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, threeOfSpades, twoOfSpades}, playingDeck.Drawn)
+
+	_, err = playingDeck.DrawCardByCodes([]string{"4S", "AS"})
+	assert.ErrorIs(t, err, ErrCardUnavailable)
+	_, err = playingDeck.DrawCardByCodes([]string{"4S", "KH"})
+	assert.ErrorIs(t, err, ErrCardNotFound)
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, playingDeck.Cards, "expected the failed draws to draw no card")
+	assert.Equal(t, 1, playingDeck.Remaining)
+}
+
+func TestDrawCardStrictly(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	drawnCards, err := playingDeck.DrawCardStrictly(3, DrawTop)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}, drawnCards)
+
+	_, err = playingDeck.DrawCardStrictly(2, DrawTop)
+	var insufficientCardsError *InsufficientCardsError
+	assert.ErrorIs(t, err, ErrInsufficientCards)
+	assert.ErrorAs(t, err, &insufficientCardsError)
+	assert.Equal(t, &InsufficientCardsError{Requested: 2, Remaining: 1}, insufficientCardsError)
+	assert.Equal(t, 1, playingDeck.Remaining, "expected the failed draw to draw no card")
+
+	for _, count := range []int{0, -1} {
+		_, err = playingDeck.DrawCardStrictly(count, DrawTop)
+		assert.ErrorIs(t, err, ErrInvalidDrawCount)
+	}
+
+	drawnCards, err = playingDeck.DrawCardStrictly(1, DrawBottom)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, drawnCards)
+	assert.Equal(t, 0, playingDeck.Remaining)
+}
@@ -0,0 +1,117 @@
+package decks
+
+import (
+	"crypto/hmac"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// ErrNotProvablyFair is returned when the fairness of a deck which is not provably fair is requested.
+var ErrNotProvablyFair = errors.New("deck is not provably fair")
+
+// ErrRevealUnavailable is returned when the secrets of a deck are requested before they can be disclosed.
+var ErrRevealUnavailable = errors.New("deck can only be revealed once exhausted or closed")
+
+// serverSeedSize is the number of random bytes of a server seed.
+const serverSeedSize = 32
+
+// Fairness is the representation of the commitment made upon the shuffle of a provably fair deck.
+//
+// Commitment is the SHA-256 hash of the ServerSeed, the ClientSeed and the shuffled Order, published
+// upon the creation of the deck.
+// ServerSeed and Order are kept secret until the deck is exhausted or closed, so that anyone can then
+// recompute the Commitment and verify the order of the cards was settled from the start.
+type Fairness struct {
+	Commitment string   `json:"commitment"`
+	ClientSeed string   `json:"client_seed"`
+	ServerSeed string   `json:"server_seed,omitempty"`
+	Order      []string `json:"order,omitempty"`
+}
+
+// ComputeCommitment returns the commitment of the provided seeds and order of card codes, i.e. the
+// hexadecimal SHA-256 hash of "<serverSeed>:<clientSeed>:<comma separated order>".
+func ComputeCommitment(serverSeed string, clientSeed string, order []string) string {
+	hash := sha256.Sum256([]byte(serverSeed + ":" + clientSeed + ":" + strings.Join(order, ",")))
+	return hex.EncodeToString(hash[:])
+}
+
+// Verify returns true if the Commitment matches the ServerSeed, the ClientSeed and the Order.
+func (fairness Fairness) Verify() bool {
+	return fairness.ServerSeed != "" && fairness.Commitment == ComputeCommitment(fairness.ServerSeed, fairness.ClientSeed, fairness.Order)
+}
+
+// clone returns a deep copy of the fairness.
+func (fairness Fairness) clone() *Fairness {
+	fairness.Order = append([]string(nil), fairness.Order...)
+	return &fairness
+}
+
+// ProvablyFairSeed returns the shuffle seed derived from serverSeed and clientSeed, i.e. the first
+// 8 bytes, read as a big-endian integer, of the HMAC-SHA256 of clientSeed keyed by serverSeed.
+func ProvablyFairSeed(serverSeed string, clientSeed string) int64 {
+	mac := hmac.New(sha256.New, []byte(serverSeed))
+	mac.Write([]byte(clientSeed))
+	return int64(binary.BigEndian.Uint64(mac.Sum(nil)[:8]))
+}
+
+// ShuffleProvablyFair shuffles the cards contained in a playable deck with a random server seed
+// mixed with clientSeed, and commits to the resulting order in Fairness.
+// The seed of the shuffle is kept secret, so Seed is set to nil.
+// A successful ShuffleProvablyFair returns err == nil.
+func (deck *PlayableDeck) ShuffleProvablyFair(clientSeed string) error {
+	serverSeedBytes := make([]byte, serverSeedSize)
+	if _, err := cryptorand.Read(serverSeedBytes); err != nil {
+		return err
+	}
+	serverSeed := hex.EncodeToString(serverSeedBytes)
+
+	deck.shuffleCards(newSeededRandomSource(ProvablyFairSeed(serverSeed, clientSeed)), nil)
+	order := make([]string, len(deck.Cards))
+	for i, card := range deck.Cards {
+		order[i] = card.Code
+	}
+	deck.Fairness = &Fairness{
+		Commitment: ComputeCommitment(serverSeed, clientSeed, order),
+		ClientSeed: clientSeed,
+		ServerSeed: serverSeed,
+		Order:      order,
+	}
+	return nil
+}
+
+// Close closes a playable deck, so that its secrets can be revealed.
+func (deck *PlayableDeck) Close() {
+	deck.Closed = true
+}
+
+// IsRevealable returns true if the secrets of a playable deck can be disclosed, i.e. once the deck
+// is exhausted or closed.
+func (deck *PlayableDeck) IsRevealable() bool {
+	return deck.Closed || deck.Remaining == 0
+}
+
+// Reveal returns a copy of the Fairness of a playable deck, including its secrets.
+// Reveal fails with ErrNotProvablyFair if the deck is not provably fair, or with
+// ErrRevealUnavailable if the deck is neither exhausted nor closed.
+func (deck *PlayableDeck) Reveal() (*Fairness, error) {
+	if deck.Fairness == nil {
+		return nil, ErrNotProvablyFair
+	}
+	if !deck.IsRevealable() {
+		return nil, ErrRevealUnavailable
+	}
+	return deck.Fairness.clone(), nil
+}
+
+// Conceal removes the secrets of a playable deck which cannot be disclosed yet, so that the deck
+// can be safely exposed.
+func (deck *PlayableDeck) Conceal() {
+	if deck.Fairness == nil || deck.IsRevealable() {
+		return
+	}
+	deck.Fairness = &Fairness{Commitment: deck.Fairness.Commitment, ClientSeed: deck.Fairness.ClientSeed}
+}
@@ -0,0 +1,92 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestComputeCommitment(t *testing.T) {
+	commitment := ComputeCommitment("server", "client", []string{"AS", "2S"})
+	assert.Equal(t, "bfaf9eb27ce4eb0faed30f1f98e5aa552fcaf9ef1dc948116cafc6a737d63b5b", commitment)
+}
+
+func TestProvablyFairSeed(t *testing.T) {
+	seed := ProvablyFairSeed("server", "client")
+	assert.Equal(t, seed, ProvablyFairSeed("server", "client"), "expected a deterministic seed")
+	assert.NotEqual(t, seed, ProvablyFairSeed("server", "other client"), "expected the client seed to be mixed in")
+	assert.NotEqual(t, seed, ProvablyFairSeed("other server", "client"), "expected the server seed to be mixed in")
+}
+
+func TestShuffleProvablyFair(t *testing.T) {
+	sortedDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French}, nil)
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, ProvablyFair: true, ClientSeed: "lucky"}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.True(t, playingDeck.Shuffled, "expected a shuffled deck")
+	assert.Nil(t, playingDeck.Seed, "expected the shuffle seed to be kept secret")
+	assert.Nil(t, playingDeck.History[0].Seed, "expected the shuffle seed to be kept out of the history")
+
+	fairness := playingDeck.Fairness
+	assert.NotNil(t, fairness, "expected a shuffle commitment")
+	assert.Equal(t, "lucky", fairness.ClientSeed)
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+	for i, card := range playingDeck.Cards {
+		assert.Equal(t, card.Code, fairness.Order[i], "expected the commitment to cover the order of the cards")
+	}
+
+	replayedDeck := sortedDeck.Clone()
+	replayedDeck.ShuffleWithSeed(ProvablyFairSeed(fairness.ServerSeed, fairness.ClientSeed))
+	assert.Equal(t, playingDeck.Cards, replayedDeck.Cards, "expected the order to be recomputed out of the seeds")
+}
+
+func TestFairnessVerify(t *testing.T) {
+	fairness := Fairness{ServerSeed: "server", ClientSeed: "client", Order: []string{"AS", "2S"}}
+	fairness.Commitment = ComputeCommitment(fairness.ServerSeed, fairness.ClientSeed, fairness.Order)
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+
+	tamperedFairness := *fairness.clone()
+	tamperedFairness.Order = []string{"2S", "AS"}
+	assert.False(t, tamperedFairness.Verify(), "expected a tampered order to be detected")
+
+	concealedFairness := Fairness{Commitment: fairness.Commitment, ClientSeed: fairness.ClientSeed}
+	assert.False(t, concealedFairness.Verify(), "expected a concealed commitment not to be verifiable")
+}
+
+func TestReveal(t *testing.T) {
+	playingDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, ProvablyFair: true}, []string{"AS", "2S"})
+
+	fairness, err := playingDeck.Reveal()
+	assert.Nil(t, fairness, "expected no fairness")
+	assert.ErrorIs(t, err, ErrRevealUnavailable)
+
+	playingDeck.DrawCard(2)
+	fairness, err = playingDeck.Reveal()
+	assert.Nil(t, err, "expected no error once the deck is exhausted")
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+
+	closedDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, ProvablyFair: true}, nil)
+	closedDeck.Close()
+	fairness, err = closedDeck.Reveal()
+	assert.Nil(t, err, "expected no error once the deck is closed")
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+
+	unfairDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true}, nil)
+	unfairDeck.Close()
+	_, err = unfairDeck.Reveal()
+	assert.ErrorIs(t, err, ErrNotProvablyFair)
+}
+
+func TestConceal(t *testing.T) {
+	playingDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, ProvablyFair: true}, nil)
+	fairness := *playingDeck.Fairness
+
+	concealedDeck := playingDeck.Clone()
+	concealedDeck.Conceal()
+	assert.Equal(t, &Fairness{Commitment: fairness.Commitment, ClientSeed: fairness.ClientSeed}, concealedDeck.Fairness)
+	assert.Equal(t, fairness.ServerSeed, playingDeck.Fairness.ServerSeed, "expected the original deck to be untouched")
+
+	playingDeck.Close()
+	revealedDeck := playingDeck.Clone()
+	revealedDeck.Conceal()
+	assert.Equal(t, &fairness, revealedDeck.Fairness, "expected the secrets of a closed deck to be disclosed")
+}
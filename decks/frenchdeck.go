@@ -3,9 +3,8 @@ package decks
 import (
 	"croupier.io/cards"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
-	"strings"
-	"unicode"
 )
 
 // FrenchDeck is the representation of a deck containing French-suited playable cards.
@@ -15,12 +14,62 @@ type FrenchDeck struct {
 
 var _ Deck = &FrenchDeck{}
 
-// NewFrenchDeck creates and returns a FrenchDeck according to the French-suited card standards.
+const (
+	// FrenchPiquet is the variant of a FrenchDeck containing the 32 cards from the 7 to the Ace of
+	// every suit.
+	FrenchPiquet = "piquet"
+	// FrenchEuchre is the variant of a FrenchDeck containing the 24 cards from the 9 to the Ace of
+	// every suit.
+	FrenchEuchre = "euchre"
+	// FrenchPinochle is the variant of a FrenchDeck containing two copies of the cards from the 9
+	// to the Ace of every suit, 48 cards in total.
+	FrenchPinochle = "pinochle"
+)
+
+// FrenchDeckOption is the representation of an option applied upon the creation of a FrenchDeck.
+type FrenchDeckOption func(options *frenchDeckOptions)
+
+// frenchDeckOptions is the representation of the options applied upon the creation of a FrenchDeck.
+type frenchDeckOptions struct {
+	jokers  bool
+	variant string
+	points  cards.FrenchCardPoints
+}
+
+// WithJokers adds the red and black jokers to a FrenchDeck.
+func WithJokers() FrenchDeckOption {
+	return func(options *frenchDeckOptions) {
+		options.jokers = true
+	}
+}
+
+// WithVariant restricts a FrenchDeck to the cards of variant e.g. FrenchPiquet.
+// The standard 52 cards are used if variant is empty.
+func WithVariant(variant string) FrenchDeckOption {
+	return func(options *frenchDeckOptions) {
+		options.variant = variant
+	}
+}
+
+// WithPoints overrides the default points of the cards of a FrenchDeck according to points.
+func WithPoints(points cards.FrenchCardPoints) FrenchDeckOption {
+	return func(options *frenchDeckOptions) {
+		options.points = points
+	}
+}
+
+// NewFrenchDeck creates and returns a FrenchDeck according to the French-suited card standards
+// and to the provided options.
+// NewFrenchDeck fails with cards.CodeErrors if any of the requested card codes cannot be parsed.
 // A successful NewFrenchDeck returns err == nil.
-func NewFrenchDeck(requestedCardCodes []string) (*FrenchDeck, error) {
-	playingCards, err := generateFrenchDeckPlayingCards(requestedCardCodes)
+func NewFrenchDeck(requestedCardCodes []string, options ...FrenchDeckOption) (*FrenchDeck, error) {
+	var deckOptions frenchDeckOptions
+	for _, option := range options {
+		option(&deckOptions)
+	}
+	playingCards, err := generateFrenchDeckPlayingCards(requestedCardCodes, deckOptions)
 	if err != nil {
-		return nil, errors.New("french playing cards creation failure on deck generation")
+		return nil, fmt.Errorf("french playing cards creation failure on deck generation: %w", err)
 	}
 	return &FrenchDeck{
 		PlayableDeck: PlayableDeck{
@@ -32,65 +81,110 @@ func NewFrenchDeck(requestedCardCodes []string) (*FrenchDeck, error) {
 	}, nil
 }
 
+// validateFrenchVariant ensures variant is a supported variant of a FrenchDeck.
+func validateFrenchVariant(variant string) error {
+	switch variant {
+	case "", FrenchPiquet, FrenchEuchre, FrenchPinochle:
+		return nil
+	}
+	return fmt.Errorf("unsupported french deck variant '%s'", variant)
+}
+
+// frenchVariantValues returns the values of the cards of every suit of variant, and the number of
+// copies of every card.
+func frenchVariantValues(variant string) ([]string, int) {
+	lowestValue := ""
+	copies := 1
+	switch variant {
+	case FrenchPiquet:
+		lowestValue = "7"
+	case FrenchEuchre:
+		lowestValue = "9"
+	case FrenchPinochle:
+		lowestValue = "9"
+		copies = 2
+	default:
+		return cards.FrenchCardValues[:], copies
+	}
+	values := []string{cards.FrenchCardValues[0]}
+	for i, value := range cards.FrenchCardValues {
+		if value == lowestValue {
+			values = append(values, cards.FrenchCardValues[i:]...)
+		}
+	}
+	return values, copies
+}
+
 // generateFrenchDeckPlayingCards generates and return a slice of cards.PlayingCard according to
-// the French-suited card standards.
-// generateFrenchDeckPlayingCards creates a standard set of French-suited cards if requestedCardCodes is empty.
-// If requestedCardCodes contains unrecognizable card codes according to the French-suited card standards,
-// an error is returned.
+// the French-suited card standards and to the variant requested by options.
+// generateFrenchDeckPlayingCards creates a standard set of French-suited cards if requestedCardCodes is empty,
+// followed by the jokers if requested by options.
+// The jokers can always be requested through requestedCardCodes.
+// The points of the cards are overridden by the points requested by options, if any.
+// The copies of the cards of a variant are marked with the 1-based index of their copy, and
+// every copy of a requested card is part of the partial deck.
+// The requested card codes are parsed with cards.ParseCode, so that they are case-insensitive and
+// accept aliases e.g. "TH" or "ace of spades".
+// If requestedCardCodes contains unparsable card codes, cards.CodeErrors is returned, and if it
+// contains unrecognizable card codes according to the French-suited card standards, an error is
+// returned.
 // A successful generateFrenchDeckPlayingCards returns err == nil.
-func generateFrenchDeckPlayingCards(requestedCardCodes []string) ([]cards.PlayingCard, error) {
+func generateFrenchDeckPlayingCards(requestedCardCodes []string, options frenchDeckOptions) ([]cards.PlayingCard, error) {
+	if err := validateFrenchVariant(options.variant); err != nil {
+		return nil, err
+	}
 	var playingCards []cards.PlayingCard
+	var jokers []cards.PlayingCard
 
-	refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes)
-	generatedCards := make(map[string]cards.PlayingCard)
-
+	values, copies := frenchVariantValues(options.variant)
 	for _, suit := range cards.FrenchCardSuits {
-		for _, value := range cards.FrenchCardValues {
+		for _, value := range values {
 			card, err := cards.NewFrenchCard(suit.String(), value)
 			if err != nil {
 				return nil, errors.New("french playing cards creation failure on deck generation")
 			}
-			generatedCards[card.Code] = card.PlayingCard
+			card.ApplyPoints(options.points)
 			playingCards = append(playingCards, card.PlayingCard)
 		}
 	}
-	if len(refinedRequestedCardCodes) > 0 {
-		var requestedCards []cards.PlayingCard
-		for _, cardCode := range refinedRequestedCardCodes {
-			_, isPresent := generatedCards[cardCode]
-			if !isPresent {
-				return nil, errors.New("requested cards code does not exist in the standard deck")
-			}
-			requestedCards = append(requestedCards, generatedCards[cardCode])
+	for _, color := range cards.FrenchJokerColors {
+		card, err := cards.NewFrenchJoker(color.String())
+		if err != nil {
+			return nil, errors.New("french playing cards creation failure on deck generation")
 		}
-		return requestedCards, nil
+		card.ApplyPoints(options.points)
+		jokers = append(jokers, card.PlayingCard)
 	}
-	return playingCards, nil
+	parsedCardCodes, err := cards.ParseCodes(requestedCardCodes)
+	if err != nil {
+		return nil, err
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(parsedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		requestedCards, err := selectRequestedCards(append(playingCards, jokers...), refinedRequestedCardCodes)
+		if err != nil {
+			return nil, err
+		}
+		return copyPlayingCards(requestedCards, copies), nil
+	}
+	if options.jokers {
+		playingCards = append(playingCards, jokers...)
+	}
+	return copyPlayingCards(playingCards, copies), nil
 }
 
-// refineRequestedCardCodes returns a processable version of requestedCardCodes
-// by removing any duplicates or removing any whitespace contained in the provided card codes.
-func refineRequestedCardCodes(requestedCardCodes []string) []string {
-	if len(requestedCardCodes) == 0 {
-		return []string{}
+// copyPlayingCards returns copies successive copies of playingCards, every card being marked with
+// the 1-based index of its copy.
+// copyPlayingCards returns playingCards if a single copy is requested.
+func copyPlayingCards(playingCards []cards.PlayingCard, copies int) []cards.PlayingCard {
+	if copies <= 1 {
+		return playingCards
 	}
-
-	refinedRequestedCardCodes := make([]string, 0)
-	requestedCardOccurrences := make(map[string]int)
-	for _, cardCode := range requestedCardCodes {
-		formattedCardCode := strings.Map(func(r rune) rune {
-			if unicode.IsSpace(r) {
-				return -1
-			}
-			return r
-		}, cardCode)
-		if formattedCardCode != "" {
-			_, isPresent := requestedCardOccurrences[cardCode]
-			if !isPresent {
-				refinedRequestedCardCodes = append(refinedRequestedCardCodes, cardCode)
-				requestedCardOccurrences[cardCode] = 1
-			}
+	copiedCards := make([]cards.PlayingCard, 0, len(playingCards)*copies)
+	for copyIndex := 1; copyIndex <= copies; copyIndex++ {
+		for _, card := range playingCards {
+			card.CopyIndex = copyIndex
+			copiedCards = append(copiedCards, card)
 		}
 	}
-	return refinedRequestedCardCodes
+	return copiedCards
 }
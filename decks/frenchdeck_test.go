@@ -51,10 +51,11 @@ func TestGenerateFrenchDeckPlayingCards(t *testing.T) {
 		{[]string{"AS", "5S", "10S", "AH", "KH", "2D"}, []string{"AS", "5S", "10S", "AH", "KH", "2D"}, 6},
 		{[]string{"AS", "    ", "5S", ""}, []string{"AS", "5S"}, 2},
 		{[]string{"X", "AS"}, []string{}, -1},
+		{[]string{"AS", "JR", "JB"}, []string{"AS", "JR", "JB"}, 3},
 	}
 
 	for _, testRecord := range testRecords {
-		playingCards, err := generateFrenchDeckPlayingCards(testRecord.requestedCardCodes)
+		playingCards, err := generateFrenchDeckPlayingCards(testRecord.requestedCardCodes, frenchDeckOptions{})
 		if testRecord.expectedCardsSize == -1 {
 			assert.Nil(t, playingCards, "expected no playing cards")
 			assert.NotNil(t, err, "expected an error when generating the deck")
@@ -71,6 +72,27 @@ func TestGenerateFrenchDeckPlayingCards(t *testing.T) {
 	}
 }
 
+func TestNewFrenchDeckWithJokers(t *testing.T) {
+	standardDeck, _ := NewFrenchDeck([]string{})
+	redJoker, _ := cards.NewFrenchJoker(cards.RedJoker.String())
+	blackJoker, _ := cards.NewFrenchJoker(cards.BlackJoker.String())
+
+	actualDeck, err := NewFrenchDeck([]string{}, WithJokers())
+	assert.Nil(t, err, "expected no error when generating the deck")
+	assert.Equal(t, append(standardDeck.Cards, redJoker.PlayingCard, blackJoker.PlayingCard), actualDeck.Cards)
+	assert.Equal(t, len(standardDeck.Cards)+2, actualDeck.Remaining)
+}
+
+func TestNewFrenchDeckWithPoints(t *testing.T) {
+	actualDeck, err := NewFrenchDeck([]string{"AS", "QS", "QH", "9H", "JR"}, WithPoints(cards.FrenchCardPoints{"ACE": 11, "QUEEN": 3, "QS": 13, "JOKER": 50}), WithVariant(FrenchPinochle))
+	assert.Nil(t, err, "expected no error when generating the deck")
+	points := make([]int, 0, len(actualDeck.Cards))
+	for _, card := range actualDeck.Cards {
+		points = append(points, card.Metadata.Points)
+	}
+	assert.Equal(t, []int{11, 13, 3, 9, 50, 11, 13, 3, 9, 50}, points, "expected the requested points on every copy")
+}
+
 func TestNewFrenchDeckWithEmptyProperties(t *testing.T) {
 	testRecords := []struct {
 		cardSuits  [4]cards.FrenchCardSuit
@@ -95,3 +117,75 @@ func TestNewFrenchDeckWithEmptyProperties(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFrenchDeckWithVariant(t *testing.T) {
+	testRecords := []struct {
+		variant        string
+		expectedCount  int
+		expectedValues []string
+		expectedCopies int
+	}{
+		{"", 52, cards.FrenchCardValues[:], 1},
+		{FrenchPiquet, 32, []string{"ACE", "7", "8", "9", "10", "JACK", "QUEEN", "KING"}, 1},
+		{FrenchEuchre, 24, []string{"ACE", "9", "10", "JACK", "QUEEN", "KING"}, 1},
+		{FrenchPinochle, 48, []string{"ACE", "9", "10", "JACK", "QUEEN", "KING"}, 2},
+	}
+	for _, testRecord := range testRecords {
+		actualDeck, err := NewFrenchDeck([]string{}, WithVariant(testRecord.variant))
+		assert.Nil(t, err, "expected no error when generating the '%s' deck", testRecord.variant)
+		assert.Equal(t, testRecord.expectedCount, actualDeck.Remaining)
+
+		values := make(map[string]bool)
+		occurrences := make(map[string]int)
+		for _, card := range actualDeck.Cards {
+			values[card.Value] = true
+			occurrences[card.Code] += 1
+			if testRecord.expectedCopies > 1 {
+				assert.Equal(t, occurrences[card.Code], card.CopyIndex, "expected the copies to be numbered")
+			} else {
+				assert.Zero(t, card.CopyIndex, "expected no copy index")
+			}
+		}
+		assert.Len(t, values, len(testRecord.expectedValues))
+		for _, value := range testRecord.expectedValues {
+			assert.True(t, values[value], "expected the '%s' variant to contain the value '%s'", testRecord.variant, value)
+		}
+		for code, occurrence := range occurrences {
+			assert.Equal(t, testRecord.expectedCopies, occurrence, "expected %d copies of '%s'", testRecord.expectedCopies, code)
+		}
+	}
+
+	_, err := NewFrenchDeck([]string{}, WithVariant("bridge"))
+	assert.NotNil(t, err, "expected an error for an unsupported variant")
+}
+
+func TestNewFrenchDeckWithVariantAndCards(t *testing.T) {
+	actualDeck, err := NewFrenchDeck([]string{"AS", "JD"}, WithVariant(FrenchPinochle))
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, actualDeck.Cards, 4, "expected both copies of the requested cards")
+	assert.Equal(t, "AS", actualDeck.Cards[2].Code)
+	assert.Equal(t, 2, actualDeck.Cards[2].CopyIndex)
+
+	_, err = NewFrenchDeck([]string{"2S"}, WithVariant(FrenchEuchre))
+	assert.NotNil(t, err, "expected an error for a card out of the variant")
+
+	actualDeck, err = NewFrenchDeck([]string{}, WithVariant(FrenchEuchre), WithJokers())
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, actualDeck.Cards, 26, "expected the jokers to be added to the variant")
+}
+
+func TestNewFrenchDeckWithCardCodeAliases(t *testing.T) {
+	actualDeck, err := NewFrenchDeck([]string{"as", "ace of spades", "T♥", " 10h", "jr"})
+	assert.Nil(t, err, "expected no error")
+	actualCodes := make([]string, 0, len(actualDeck.Cards))
+	for _, card := range actualDeck.Cards {
+		actualCodes = append(actualCodes, card.Code)
+	}
+	assert.Equal(t, []string{"AS", "10H", "JR"}, actualCodes, "expected the aliases to be parsed and refined")
+
+	_, err = NewFrenchDeck([]string{"AS", "1S", "ZZ"})
+	assert.ErrorIs(t, err, cards.ErrInvalidCode)
+	var codeErrors cards.CodeErrors
+	assert.ErrorAs(t, err, &codeErrors)
+	assert.Len(t, codeErrors, 2, "expected an error per invalid card code")
+}
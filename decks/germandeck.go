@@ -0,0 +1,79 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// GermanDeck is the representation of a deck containing German-suited playable cards.
+type GermanDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &GermanDeck{}
+
+const (
+	// German32 is the variant of a GermanDeck leaving out the 6 of every suit, used to play Skat.
+	German32 = "32"
+	// German36 is the variant of a GermanDeck containing every value of every suit.
+	German36 = "36"
+)
+
+// NewGermanDeck creates and returns a GermanDeck according to the German-suited card standards
+// and to the provided variant, German32 if variant is empty.
+// A successful NewGermanDeck returns err == nil.
+func NewGermanDeck(requestedCardCodes []string, variant string) (*GermanDeck, error) {
+	playingCards, err := generateGermanDeckPlayingCards(requestedCardCodes, variant)
+	if err != nil {
+		return nil, fmt.Errorf("german playing cards creation failure on deck generation: %w", err)
+	}
+	return &GermanDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// validateGermanVariant ensures variant is a supported variant of a GermanDeck.
+func validateGermanVariant(variant string) error {
+	switch variant {
+	case "", German32, German36:
+		return nil
+	}
+	return fmt.Errorf("unsupported german deck variant '%s'", variant)
+}
+
+// generateGermanDeckPlayingCards generates and return a slice of cards.PlayingCard according to
+// the German-suited card standards and to variant.
+// generateGermanDeckPlayingCards creates a standard set of German-suited cards if
+// requestedCardCodes is empty.
+// If requestedCardCodes contains unrecognizable card codes according to the variant, an error is
+// returned.
+// A successful generateGermanDeckPlayingCards returns err == nil.
+func generateGermanDeckPlayingCards(requestedCardCodes []string, variant string) ([]cards.PlayingCard, error) {
+	if err := validateGermanVariant(variant); err != nil {
+		return nil, err
+	}
+	var playingCards []cards.PlayingCard
+	for _, suit := range cards.GermanCardSuits {
+		for _, value := range cards.GermanCardValues {
+			if variant != German36 && value == "6" {
+				continue
+			}
+			card, err := cards.NewGermanCard(suit.String(), value)
+			if err != nil {
+				return nil, errors.New("german playing cards creation failure on deck generation")
+			}
+			playingCards = append(playingCards, card.PlayingCard)
+		}
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCards(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
@@ -0,0 +1,69 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewGermanDeck(t *testing.T) {
+	testRecords := []struct {
+		variant       string
+		expectedCount int
+		expectedSixes int
+	}{
+		{"", 32, 0},
+		{German32, 32, 0},
+		{German36, 36, 4},
+	}
+	for _, testRecord := range testRecords {
+		actualDeck, err := NewGermanDeck([]string{}, testRecord.variant)
+		assert.Nil(t, err, "expected no error when generating the deck")
+		assert.Equal(t, testRecord.expectedCount, actualDeck.Remaining)
+		assert.False(t, actualDeck.Shuffled)
+
+		sixes := 0
+		for _, card := range actualDeck.Cards {
+			if card.Value == "6" {
+				sixes += 1
+			}
+		}
+		assert.Equal(t, testRecord.expectedSixes, sixes, "expected %d sixes in the '%s' variant", testRecord.expectedSixes, testRecord.variant)
+	}
+}
+
+func TestGenerateGermanDeckPlayingCards(t *testing.T) {
+	testRecords := []struct {
+		requestedCardCodes []string
+		variant            string
+		expectedCardCodes  []string
+	}{
+		{[]string{"AE", "OG", "KH", "10S"}, "", []string{"AE", "OG", "KH", "10S"}},
+		{[]string{"6H"}, German36, []string{"6H"}},
+		{[]string{"6H"}, German32, nil},
+		{[]string{"QH"}, "", nil},
+		{[]string{}, "52", nil},
+	}
+	for _, testRecord := range testRecords {
+		playingCards, err := generateGermanDeckPlayingCards(testRecord.requestedCardCodes, testRecord.variant)
+		if testRecord.expectedCardCodes == nil {
+			assert.Nil(t, playingCards, "expected no playing cards")
+			assert.NotNil(t, err, "expected an error when generating the deck")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		for i, card := range playingCards {
+			assert.Equal(t, testRecord.expectedCardCodes[i], card.Code, "requested cards code and expected cards code do not match")
+		}
+	}
+}
+
+func TestCreateGermanDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.German, Variant: German36}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.German, playingDeck.Type)
+	assert.Equal(t, 36, playingDeck.Remaining)
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.German, Variant: "52"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
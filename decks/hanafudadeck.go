@@ -0,0 +1,66 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// HanafudaDeck is the representation of a deck containing Hanafuda playable cards.
+type HanafudaDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &HanafudaDeck{}
+
+// NewHanafudaDeck creates and returns a HanafudaDeck according to the Hanafuda card standards.
+// A successful NewHanafudaDeck returns err == nil.
+func NewHanafudaDeck(requestedCardCodes []string) (*HanafudaDeck, error) {
+	playingCards, err := generateHanafudaDeckPlayingCards(requestedCardCodes)
+	if err != nil {
+		return nil, fmt.Errorf("hanafuda playing cards creation failure on deck generation: %w", err)
+	}
+	return &HanafudaDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// generateHanafudaDeckPlayingCards generates and return a slice of cards.PlayingCard according to
+// the Hanafuda card standards.
+// generateHanafudaDeckPlayingCards creates the 48 cards of a Hanafuda deck, month after month, if
+// requestedCardCodes is empty.
+// The chaff cards of a same month share their code and are marked with the 1-based index of their
+// copy. Every copy of a requested card is part of the partial deck.
+// If requestedCardCodes contains unrecognizable card codes according to the Hanafuda card
+// standards, an error is returned.
+// A successful generateHanafudaDeckPlayingCards returns err == nil.
+func generateHanafudaDeckPlayingCards(requestedCardCodes []string) ([]cards.PlayingCard, error) {
+	var playingCards []cards.PlayingCard
+	for i, month := range cards.HanafudaMonths {
+		monthCards := cards.HanafudaMonthCards[i]
+		for j, name := range monthCards {
+			if j > 0 && monthCards[j-1] == name {
+				continue
+			}
+			copies := 1
+			for j+copies < len(monthCards) && monthCards[j+copies] == name {
+				copies += 1
+			}
+			card, err := cards.NewHanafudaCard(month.String(), name)
+			if err != nil {
+				return nil, errors.New("hanafuda playing cards creation failure on deck generation")
+			}
+			playingCards = append(playingCards, copyPlayingCards([]cards.PlayingCard{card.PlayingCard}, copies)...)
+		}
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCopies(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
@@ -0,0 +1,55 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewHanafudaDeck(t *testing.T) {
+	actualDeck, err := NewHanafudaDeck([]string{})
+	assert.Nil(t, err, "expected no error when generating the deck")
+	assert.Equal(t, 48, actualDeck.Remaining)
+	assert.False(t, actualDeck.Shuffled)
+
+	monthOccurrences := make(map[string]int)
+	categoryOccurrences := make(map[string]int)
+	for _, card := range actualDeck.Cards {
+		monthOccurrences[card.Suit] += 1
+		categoryOccurrences[card.Attributes[cards.HanafudaCategoryAttribute]] += 1
+	}
+	for _, month := range cards.HanafudaMonths {
+		assert.Equal(t, 4, monthOccurrences[month.String()], "expected 4 cards of %s", month)
+	}
+	assert.Equal(t, map[string]int{"BRIGHT": 5, "ANIMAL": 9, "RIBBON": 10, "CHAFF": 24}, categoryOccurrences)
+
+	assert.Equal(t, "1B", actualDeck.Cards[0].Code)
+	assert.Equal(t, "CRANE", actualDeck.Cards[0].Value)
+	assert.Equal(t, 1, actualDeck.Cards[2].CopyIndex, "expected the chaff copies to be traceable")
+	assert.Equal(t, 2, actualDeck.Cards[3].CopyIndex, "expected the chaff copies to be traceable")
+	assert.Equal(t, "11C", actualDeck.Cards[43].Code)
+	assert.Equal(t, 0, actualDeck.Cards[43].CopyIndex, "expected the Lightning to be a single card")
+	assert.Equal(t, 3, actualDeck.Cards[47].CopyIndex)
+}
+
+func TestGenerateHanafudaDeckPlayingCards(t *testing.T) {
+	playingCards, err := generateHanafudaDeckPlayingCards([]string{"8B", "12C"})
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, playingCards, 4, "expected every copy of the requested cards")
+	assert.Equal(t, "MOON", playingCards[0].Value)
+	assert.Equal(t, "PAULOWNIA", playingCards[3].Suit)
+
+	playingCards, err = generateHanafudaDeckPlayingCards([]string{"13B"})
+	assert.Nil(t, playingCards, "expected no playing cards")
+	assert.NotNil(t, err, "expected an error when generating the deck")
+}
+
+func TestCreateHanafudaDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.Hanafuda}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Hanafuda, playingDeck.Type)
+	assert.Equal(t, 48, playingDeck.Remaining)
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Hanafuda, Variant: "koi-koi"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
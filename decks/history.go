@@ -0,0 +1,58 @@
+package decks
+
+// OperationType is the representation of the type of an operation recorded in the history of a deck.
+type OperationType string
+
+const (
+	// ShuffleOperation is the type of the shuffles of a deck.
+	ShuffleOperation OperationType = "shuffle"
+	// CutOperation is the type of the cuts of a deck.
+	CutOperation OperationType = "cut"
+	// GatherOperation is the type of the operations gathering every card of a deck back in the deck.
+	GatherOperation OperationType = "gather"
+	// RandomDrawOperation is the type of the draws of cards at random from a deck.
+	RandomDrawOperation OperationType = "random_draw"
+	// RandomReturnOperation is the type of the returns of cards at random positions in a deck.
+	RandomReturnOperation OperationType = "random_return"
+)
+
+// maxHistoryLength is the maximum number of operations kept in the history of a deck; the oldest
+// operations are forgotten first.
+const maxHistoryLength = 100
+
+// Operation is the representation of an operation which rearranged the cards of a deck.
+// Seed is the seed of a shuffle or of a random draw or return, if any, and ShuffleSequence the
+// shuffle sequence a shuffle relied on.
+// At is the position at which a deck was cut.
+type Operation struct {
+	Type            OperationType `json:"type"`
+	Seed            *int64        `json:"seed,omitempty"`
+	ShuffleSequence string        `json:"shuffle_sequence,omitempty"`
+	At              int           `json:"at,omitempty"`
+}
+
+// record appends operation to the history of a playable deck, forgetting the oldest operations
+// beyond maxHistoryLength.
+func (deck *PlayableDeck) record(operation Operation) {
+	deck.History = append(deck.History, operation)
+	if len(deck.History) > maxHistoryLength {
+		deck.History = append([]Operation(nil), deck.History[len(deck.History)-maxHistoryLength:]...)
+	}
+}
+
+// cloneHistory returns a deep copy of history.
+// cloneHistory returns nil if history is nil.
+func cloneHistory(history []Operation) []Operation {
+	if history == nil {
+		return nil
+	}
+	clonedHistory := make([]Operation, len(history))
+	for i, operation := range history {
+		clonedHistory[i] = operation
+		if operation.Seed != nil {
+			seed := *operation.Seed
+			clonedHistory[i].Seed = &seed
+		}
+	}
+	return clonedHistory
+}
@@ -0,0 +1,29 @@
+package decks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRecordForgetsOldestOperations(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	for i := 1; i <= maxHistoryLength+5; i++ {
+		playingDeck.record(Operation{Type: CutOperation, At: i})
+	}
+	assert.Len(t, playingDeck.History, maxHistoryLength, "expected the history to be bounded")
+	assert.Equal(t, 6, playingDeck.History[0].At, "expected the oldest operations to be forgotten")
+	assert.Equal(t, maxHistoryLength+5, playingDeck.History[maxHistoryLength-1].At, "expected the latest operation to be kept")
+}
+
+func TestCloneHistory(t *testing.T) {
+	assert.Nil(t, cloneHistory(nil), "expected no history")
+
+	seed := int64(42)
+	history := []Operation{{Type: ShuffleOperation, Seed: &seed}, {Type: CutOperation, At: 3}}
+	clonedHistory := cloneHistory(history)
+	assert.Equal(t, history, clonedHistory, "expected identical histories")
+	assert.NotSame(t, history[0].Seed, clonedHistory[0].Seed, "expected distinct seeds")
+
+	clonedHistory[1].At = 5
+	assert.Equal(t, 3, history[1].At, "expected the original history to be untouched")
+}
@@ -0,0 +1,68 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// ItalianDeck is the representation of a deck containing Italian-suited playable cards.
+type ItalianDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &ItalianDeck{}
+
+// Italian40 is the variant of an ItalianDeck containing the 40 Napoletane cards.
+const Italian40 = "40"
+
+// NewItalianDeck creates and returns an ItalianDeck according to the Italian-suited card standards.
+// A successful NewItalianDeck returns err == nil.
+func NewItalianDeck(requestedCardCodes []string) (*ItalianDeck, error) {
+	playingCards, err := generateItalianDeckPlayingCards(requestedCardCodes)
+	if err != nil {
+		return nil, fmt.Errorf("italian playing cards creation failure on deck generation: %w", err)
+	}
+	return &ItalianDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// validateItalianVariant ensures variant is a supported variant of an ItalianDeck.
+func validateItalianVariant(variant string) error {
+	switch variant {
+	case "", Italian40:
+		return nil
+	}
+	return fmt.Errorf("unsupported italian deck variant '%s'", variant)
+}
+
+// generateItalianDeckPlayingCards generates and return a slice of cards.PlayingCard according to
+// the Italian-suited card standards.
+// generateItalianDeckPlayingCards creates a standard set of Italian-suited cards if
+// requestedCardCodes is empty.
+// If requestedCardCodes contains unrecognizable card codes according to the Italian-suited card
+// standards, an error is returned.
+// A successful generateItalianDeckPlayingCards returns err == nil.
+func generateItalianDeckPlayingCards(requestedCardCodes []string) ([]cards.PlayingCard, error) {
+	var playingCards []cards.PlayingCard
+	for _, suit := range cards.ItalianCardSuits {
+		for _, value := range cards.ItalianCardValues {
+			card, err := cards.NewItalianCard(suit.String(), value)
+			if err != nil {
+				return nil, errors.New("italian playing cards creation failure on deck generation")
+			}
+			playingCards = append(playingCards, card.PlayingCard)
+		}
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCards(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
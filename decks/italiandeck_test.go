@@ -0,0 +1,48 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewItalianDeck(t *testing.T) {
+	actualDeck, err := NewItalianDeck([]string{})
+	assert.Nil(t, err, "expected no error when generating the deck")
+	assert.Equal(t, 40, actualDeck.Remaining)
+	assert.False(t, actualDeck.Shuffled)
+
+	var i = 0
+	for _, suit := range cards.ItalianCardSuits {
+		for _, value := range cards.ItalianCardValues {
+			card, err := cards.NewItalianCard(suit.String(), value)
+			assert.Nil(t, err, "expected no error upon Italian cards creation")
+			assert.Equal(t, card.PlayingCard, actualDeck.Cards[i], "expected identical cards")
+			i += 1
+		}
+	}
+}
+
+func TestGenerateItalianDeckPlayingCards(t *testing.T) {
+	playingCards, err := generateItalianDeckPlayingCards([]string{"AD", "FS", "RB"})
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, playingCards, 3)
+	assert.Equal(t, "FANTE", playingCards[1].Value)
+	assert.Equal(t, "SPADE", playingCards[1].Suit)
+
+	playingCards, err = generateItalianDeckPlayingCards([]string{"AD", "8D"})
+	assert.Nil(t, playingCards, "expected no playing cards")
+	assert.NotNil(t, err, "expected an error when generating the deck")
+}
+
+func TestCreateItalianDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.Italian}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Italian, playingDeck.Type)
+	assert.Equal(t, 40, playingDeck.Remaining)
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Italian, Variant: Italian40}, nil)
+	assert.Nil(t, err, "expected no error")
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Italian, Variant: "52"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
@@ -0,0 +1,106 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrUnknownCardCode is returned when a requested card code does not exist in the requested type of deck.
+var ErrUnknownCardCode = errors.New("requested cards code does not exist in the standard deck")
+
+// UnknownCardCodesError is the error returned when the requested card codes Codes do not exist in
+// the requested type of deck.
+// UnknownCardCodesError wraps ErrUnknownCardCode.
+type UnknownCardCodesError struct {
+	Codes []string
+}
+
+// Error returns the description of the error.
+func (err *UnknownCardCodesError) Error() string {
+	return fmt.Sprintf("%s: '%s'", ErrUnknownCardCode.Error(), strings.Join(err.Codes, "', '"))
+}
+
+// Unwrap returns ErrUnknownCardCode.
+func (err *UnknownCardCodesError) Unwrap() error {
+	return ErrUnknownCardCode
+}
+
+// selectRequestedCards returns the cards of availableCards associated with refinedRequestedCardCodes,
+// in the requested order, to create a partial deck.
+// selectRequestedCards fails with an UnknownCardCodesError, listing every unknown card code, if any
+// of the card codes is not associated with a card of availableCards.
+func selectRequestedCards(availableCards []cards.PlayingCard, refinedRequestedCardCodes []string) ([]cards.PlayingCard, error) {
+	availableCardsByCode := make(map[string]cards.PlayingCard, len(availableCards))
+	for _, card := range availableCards {
+		availableCardsByCode[card.Code] = card
+	}
+	requestedCards := make([]cards.PlayingCard, 0, len(refinedRequestedCardCodes))
+	var unknownCardCodes []string
+	for _, cardCode := range refinedRequestedCardCodes {
+		card, isPresent := availableCardsByCode[cardCode]
+		if !isPresent {
+			unknownCardCodes = append(unknownCardCodes, cardCode)
+			continue
+		}
+		requestedCards = append(requestedCards, card)
+	}
+	if len(unknownCardCodes) > 0 {
+		return nil, &UnknownCardCodesError{Codes: unknownCardCodes}
+	}
+	return requestedCards, nil
+}
+
+// refineRequestedCardCodes returns a processable version of requestedCardCodes
+// by removing any whitespace contained in the provided card codes, then any duplicates.
+func refineRequestedCardCodes(requestedCardCodes []string) []string {
+	if len(requestedCardCodes) == 0 {
+		return []string{}
+	}
+
+	refinedRequestedCardCodes := make([]string, 0)
+	requestedCardOccurrences := make(map[string]int)
+	for _, cardCode := range requestedCardCodes {
+		formattedCardCode := strings.Map(func(r rune) rune {
+			if unicode.IsSpace(r) {
+				return -1
+			}
+			return r
+		}, cardCode)
+		if formattedCardCode != "" {
+			_, isPresent := requestedCardOccurrences[formattedCardCode]
+			if !isPresent {
+				refinedRequestedCardCodes = append(refinedRequestedCardCodes, formattedCardCode)
+				requestedCardOccurrences[formattedCardCode] = 1
+			}
+		}
+	}
+	return refinedRequestedCardCodes
+}
+
+// selectRequestedCopies returns every copy of the cards of availableCards associated with
+// refinedRequestedCardCodes, in the requested order, to create a partial deck.
+// selectRequestedCopies fails with an UnknownCardCodesError, listing every unknown card code, if any
+// of the card codes is not associated with a card of availableCards.
+func selectRequestedCopies(availableCards []cards.PlayingCard, refinedRequestedCardCodes []string) ([]cards.PlayingCard, error) {
+	availableCopiesByCode := make(map[string][]cards.PlayingCard, len(availableCards))
+	for _, card := range availableCards {
+		availableCopiesByCode[card.Code] = append(availableCopiesByCode[card.Code], card)
+	}
+	requestedCards := make([]cards.PlayingCard, 0, len(refinedRequestedCardCodes))
+	var unknownCardCodes []string
+	for _, cardCode := range refinedRequestedCardCodes {
+		copies, isPresent := availableCopiesByCode[cardCode]
+		if !isPresent {
+			unknownCardCodes = append(unknownCardCodes, cardCode)
+			continue
+		}
+		requestedCards = append(requestedCards, copies...)
+	}
+	if len(unknownCardCodes) > 0 {
+		return nil, &UnknownCardCodesError{Codes: unknownCardCodes}
+	}
+	return requestedCards, nil
+}
@@ -0,0 +1,29 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSelectRequestedCards(t *testing.T) {
+	availableCards := []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}
+
+	selectedCards, err := selectRequestedCards(availableCards, []string{"3S", "AS"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, selectedCards, "expected the requested order")
+
+	selectedCards, err = selectRequestedCards(availableCards, []string{"AS", "4S"})
+	assert.Nil(t, selectedCards, "expected no card")
+	assert.ErrorIs(t, err, ErrUnknownCardCode)
+
+	_, err = selectRequestedCards(availableCards, []string{"5S", "AS", "4S"})
+	var unknownCardCodesError *UnknownCardCodesError
+	assert.ErrorAs(t, err, &unknownCardCodesError)
+	assert.Equal(t, []string{"5S", "4S"}, unknownCardCodesError.Codes, "expected every unknown card code")
+}
+
+func TestRefineRequestedCardCodes(t *testing.T) {
+	assert.Equal(t, []string{}, refineRequestedCardCodes(nil))
+	assert.Equal(t, []string{"AS", "KH"}, refineRequestedCardCodes([]string{"AS", " A S", "", "K H", "KH"}), "expected the whitespace to be removed before the duplicates")
+}
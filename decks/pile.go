@@ -0,0 +1,138 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// ErrPileNotFound is returned when a named pile does not exist in a deck.
+var ErrPileNotFound = errors.New("pile not found")
+
+// ErrInvalidPileName is returned when a pile name does not match pileNamePattern.
+var ErrInvalidPileName = errors.New("invalid pile name")
+
+// pileNamePattern is the pattern every pile name must match e.g. "player1" or "board".
+var pileNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// DrawToPile pulls a specific number of cards from the cards contained in a deck, if any, and
+// adds them to the pile named pileName.
+// The pile is created if it does not exist yet.
+// DrawToPile keeps track of Remaining and returns the drawn cards.
+// DrawToPile fails with ErrInvalidPileName if pileName is not a valid pile name.
+func (deck *PlayableDeck) DrawToPile(pileName string, requestedDrawCardCount int) ([]cards.PlayingCard, error) {
+	if !pileNamePattern.MatchString(pileName) {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidPileName, pileName)
+	}
+	playingCards := deck.drawCards(requestedDrawCardCount)
+	deck.addToPile(pileName, playingCards)
+	return playingCards, nil
+}
+
+// DrawToPileStrictly pulls exactly a specific number of cards from the cards contained in a deck
+// into the pile named pileName, like DrawToPile.
+// DrawToPileStrictly fails, without drawing any card, with ErrInvalidPileName if pileName is not a
+// valid pile name, with ErrInvalidDrawCount if requestedDrawCardCount is not positive, or with an
+// InsufficientCardsError if fewer cards remain in the deck.
+func (deck *PlayableDeck) DrawToPileStrictly(pileName string, requestedDrawCardCount int) ([]cards.PlayingCard, error) {
+	if !pileNamePattern.MatchString(pileName) {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidPileName, pileName)
+	}
+	if err := deck.checkStrictDrawCount(requestedDrawCardCount); err != nil {
+		return nil, err
+	}
+	return deck.DrawToPile(pileName, requestedDrawCardCount)
+}
+
+// Pile returns a copy of the cards contained in the pile named pileName.
+// Pile fails with ErrPileNotFound if the pile does not exist.
+func (deck *PlayableDeck) Pile(pileName string) ([]cards.PlayingCard, error) {
+	pile, isPresent := deck.Piles[pileName]
+	if !isPresent {
+		return nil, fmt.Errorf("%w: '%s'", ErrPileNotFound, pileName)
+	}
+	return cloneCards(pile), nil
+}
+
+// DrawFromPile pulls a specific number of cards from the top of the pile named pileName, if any.
+// The cards that are drawn are removed from the pile, are kept track of in Drawn and are returned.
+// DrawFromPile fails with ErrPileNotFound if the pile does not exist.
+func (deck *PlayableDeck) DrawFromPile(pileName string, requestedDrawCardCount int) ([]cards.PlayingCard, error) {
+	pile, isPresent := deck.Piles[pileName]
+	if !isPresent {
+		return nil, fmt.Errorf("%w: '%s'", ErrPileNotFound, pileName)
+	}
+	if requestedDrawCardCount < 0 {
+		requestedDrawCardCount = 0
+	}
+	if requestedDrawCardCount > len(pile) {
+		requestedDrawCardCount = len(pile)
+	}
+	playingCards := cloneCards(pile[:requestedDrawCardCount])
+	deck.Piles[pileName] = pile[requestedDrawCardCount:]
+	deck.Drawn = append(deck.Drawn, playingCards...)
+	return playingCards, nil
+}
+
+// DrawFromPileByCodes pulls the cards associated with cardCodes from the pile named pileName.
+// The cards that are drawn are removed from the pile, are kept track of in Drawn and are returned.
+// DrawFromPileByCodes fails with ErrPileNotFound if the pile does not exist, or with
+// ErrCardUnavailable, without drawing any card, if any of the cards is not contained in the pile.
+func (deck *PlayableDeck) DrawFromPileByCodes(pileName string, cardCodes []string) ([]cards.PlayingCard, error) {
+	pile, isPresent := deck.Piles[pileName]
+	if !isPresent {
+		return nil, fmt.Errorf("%w: '%s'", ErrPileNotFound, pileName)
+	}
+	cardCodes, err := deck.parseCardCodes(cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	playingCards, rest, err := takeCards(pile, cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	deck.Piles[pileName] = rest
+	deck.Drawn = append(deck.Drawn, playingCards...)
+	return playingCards, nil
+}
+
+// MoveCards moves the cards associated with cardCodes from the pile named sourcePileName to the
+// pile named targetPileName.
+// The target pile is created if it does not exist yet.
+// MoveCards returns the moved cards.
+// MoveCards fails with ErrPileNotFound if the source pile does not exist, with ErrInvalidPileName if
+// targetPileName is not a valid pile name, or with ErrCardUnavailable, without moving any card, if
+// any of the cards is not contained in the source pile.
+func (deck *PlayableDeck) MoveCards(sourcePileName string, targetPileName string, cardCodes []string) ([]cards.PlayingCard, error) {
+	sourcePile, isPresent := deck.Piles[sourcePileName]
+	if !isPresent {
+		return nil, fmt.Errorf("%w: '%s'", ErrPileNotFound, sourcePileName)
+	}
+	if !pileNamePattern.MatchString(targetPileName) {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidPileName, targetPileName)
+	}
+	cardCodes, err := deck.parseCardCodes(cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	playingCards, rest, err := takeCards(sourcePile, cardCodes)
+	if err != nil {
+		return nil, err
+	}
+	deck.Piles[sourcePileName] = rest
+	deck.addToPile(targetPileName, playingCards)
+	return playingCards, nil
+}
+
+// addToPile adds playingCards to the bottom of the pile named pileName.
+// addToPile creates the pile if it does not exist yet.
+func (deck *PlayableDeck) addToPile(pileName string, playingCards []cards.PlayingCard) {
+	if deck.Piles == nil {
+		deck.Piles = make(map[string][]cards.PlayingCard)
+	}
+	if _, isPresent := deck.Piles[pileName]; !isPresent {
+		deck.Piles[pileName] = make([]cards.PlayingCard, 0, len(playingCards))
+	}
+	deck.Piles[pileName] = append(deck.Piles[pileName], playingCards...)
+}
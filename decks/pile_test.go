@@ -0,0 +1,157 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDrawToPile(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	drawnCards, err := playingDeck.DrawToPile("player1", 2)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, drawnCards)
+
+	drawnCards, err = playingDeck.DrawToPile("player1", 1)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades}, drawnCards)
+
+	assert.Equal(t, map[string][]cards.PlayingCard{"player1": {aceOfSpades, twoOfSpades, threeOfSpades}}, playingDeck.Piles)
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 1, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Drawn, "expected the cards to be located in the pile")
+}
+
+func TestDrawToPileWithInvalidName(t *testing.T) {
+	for _, pileName := range []string{"", "player 1", "../board"} {
+		playingDeck := newDiscardTestDeck(t)
+
+		drawnCards, err := playingDeck.DrawToPile(pileName, 1)
+		assert.Nil(t, drawnCards, "expected no drawn cards")
+		assert.ErrorIs(t, err, ErrInvalidPileName)
+		assert.Equal(t, 4, playingDeck.Remaining)
+	}
+}
+
+func TestDrawToPileStrictly(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+
+	drawnCards, err := playingDeck.DrawToPileStrictly("player1", 3)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}, drawnCards)
+
+	expectedDeck := playingDeck.Clone()
+	_, err = playingDeck.DrawToPileStrictly("player1", 2)
+	var insufficientCardsError *InsufficientCardsError
+	assert.ErrorAs(t, err, &insufficientCardsError)
+	assert.Equal(t, &InsufficientCardsError{Requested: 2, Remaining: 1}, insufficientCardsError)
+	for _, count := range []int{0, -1} {
+		_, err = playingDeck.DrawToPileStrictly("player1", count)
+		assert.ErrorIs(t, err, ErrInvalidDrawCount)
+	}
+	_, err = playingDeck.DrawToPileStrictly("player 2", 1)
+	assert.ErrorIs(t, err, ErrInvalidPileName)
+	assert.Equal(t, expectedDeck, playingDeck, "expected the failed draws to draw no card")
+
+	drawnCards, err = playingDeck.DrawToPileStrictly("player2", 1)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades}, drawnCards)
+	assert.Equal(t, 0, playingDeck.Remaining)
+}
+
+func TestPile(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	_, _ = playingDeck.DrawToPile("board", 0)
+	_, _ = playingDeck.DrawToPile("player1", 2)
+
+	pile, err := playingDeck.Pile("board")
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{}, pile)
+
+	pile, err = playingDeck.Pile("player1")
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, pile)
+
+	pile, err = playingDeck.Pile("player2")
+	assert.Nil(t, pile, "expected no pile")
+	assert.ErrorIs(t, err, ErrPileNotFound)
+}
+
+func TestDrawFromPile(t *testing.T) {
+	testRecords := []struct {
+		requestedDrawCardCount int
+		expectedDrawnCards     []cards.PlayingCard
+		expectedPile           []cards.PlayingCard
+	}{
+		{-1, []cards.PlayingCard{}, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}},
+		{2, []cards.PlayingCard{aceOfSpades, twoOfSpades}, []cards.PlayingCard{threeOfSpades}},
+		{10, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}, []cards.PlayingCard{}},
+	}
+	for _, testRecord := range testRecords {
+		playingDeck := newDiscardTestDeck(t)
+		_, _ = playingDeck.DrawToPile("player1", 3)
+
+		drawnCards, err := playingDeck.DrawFromPile("player1", testRecord.requestedDrawCardCount)
+		assert.Nil(t, err, "expected no error")
+		assert.Equal(t, testRecord.expectedDrawnCards, drawnCards)
+		assert.Equal(t, testRecord.expectedPile, playingDeck.Piles["player1"])
+		assert.Equal(t, len(testRecord.expectedDrawnCards), len(playingDeck.Drawn))
+	}
+
+	playingDeck := newDiscardTestDeck(t)
+	drawnCards, err := playingDeck.DrawFromPile("player1", 1)
+	assert.Nil(t, drawnCards, "expected no drawn cards")
+	assert.ErrorIs(t, err, ErrPileNotFound)
+}
+
+func TestDrawFromPileByCodes(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	_, _ = playingDeck.DrawToPile("player1", 3)
+
+	drawnCards, err := playingDeck.DrawFromPileByCodes("player1", []string{"3S", "AS"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, drawnCards)
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, playingDeck.Piles["player1"])
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades}, playingDeck.Drawn)
+
+	expectedDeck := playingDeck.Clone()
+	drawnCards, err = playingDeck.DrawFromPileByCodes("player1", []string{"2S", "4S"})
+	assert.Nil(t, drawnCards, "expected no drawn cards")
+	assert.ErrorIs(t, err, ErrCardUnavailable)
+	assert.Equal(t, expectedDeck, playingDeck, "expected the deck to be untouched")
+
+	_, err = playingDeck.DrawFromPileByCodes("player2", []string{"2S"})
+	assert.ErrorIs(t, err, ErrPileNotFound)
+}
+
+func TestMoveCards(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	_, _ = playingDeck.DrawToPile("player1", 3)
+
+	movedCards, err := playingDeck.MoveCards("player1", "board", []string{"2S"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, movedCards)
+	assert.Equal(t, map[string][]cards.PlayingCard{
+		"player1": {aceOfSpades, threeOfSpades},
+		"board":   {twoOfSpades},
+	}, playingDeck.Piles)
+
+	testRecords := []struct {
+		sourcePileName string
+		targetPileName string
+		cardCodes      []string
+		expectedErr    error
+	}{
+		{"player2", "board", []string{"AS"}, ErrPileNotFound},
+		{"player1", "", []string{"AS"}, ErrInvalidPileName},
+		{"player1", "board", []string{"AS", "2S"}, ErrCardUnavailable},
+	}
+	for _, testRecord := range testRecords {
+		expectedDeck := playingDeck.Clone()
+		movedCards, err := playingDeck.MoveCards(testRecord.sourcePileName, testRecord.targetPileName, testRecord.cardCodes)
+		assert.Nil(t, movedCards, "expected no moved cards")
+		assert.ErrorIs(t, err, testRecord.expectedErr)
+		assert.Equal(t, expectedDeck, playingDeck, "expected the deck to be untouched")
+	}
+}
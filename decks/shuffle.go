@@ -0,0 +1,105 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+)
+
+// ShuffleMode is the representation of the source of randomness used to shuffle a deck.
+type ShuffleMode string
+
+const (
+	// SeededShuffle shuffles a deck with a pseudo-random generator initialized with a seed, so that
+	// any shuffle can be replayed.
+	SeededShuffle ShuffleMode = "seeded"
+	// SecureShuffle shuffles a deck with a cryptographically secure random generator, so that no
+	// shuffle can be predicted nor replayed.
+	SecureShuffle ShuffleMode = "secure"
+)
+
+// ErrInvalidCutPosition is returned when a deck cannot be cut at a requested position.
+var ErrInvalidCutPosition = errors.New("invalid cut position")
+
+// RandomSource is the interface that wraps the random generator used to shuffle cards.
+//
+// Intn returns a uniformly distributed random number in [0, n).
+type RandomSource interface {
+	Intn(n int) int
+}
+
+// secureRandomSource is a RandomSource relying on crypto/rand.
+type secureRandomSource struct{}
+
+var _ RandomSource = secureRandomSource{}
+
+var _ RandomSource = &rand.Rand{}
+
+// Intn returns a uniformly distributed random number in [0, n), read from crypto/rand.
+// Intn relies on the rejection sampling of crypto/rand.Int so that no number is favoured.
+// Intn panics if crypto/rand cannot provide randomness, as no secure shuffle can be performed.
+func (secureRandomSource) Intn(n int) int {
+	value, err := cryptorand.Int(cryptorand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic("secure random source failure: " + err.Error())
+	}
+	return int(value.Int64())
+}
+
+// fisherYates shuffles playingCards in place with the Fisher–Yates algorithm driven by source.
+// Every permutation of playingCards is equally likely provided that source is uniform.
+func fisherYates(playingCards []cards.PlayingCard, source RandomSource) {
+	for i := len(playingCards) - 1; i > 0; i-- {
+		j := source.Intn(i + 1)
+		playingCards[i], playingCards[j] = playingCards[j], playingCards[i]
+	}
+}
+
+// randomSource returns the RandomSource used for the random operations of a playable deck other
+// than its shuffles, according to its ShuffleMode.
+// The seed of the RandomSource of a seeded deck is recorded in History along with operationType, so
+// that the operation can be replayed.
+func (deck *PlayableDeck) randomSource(operationType OperationType) RandomSource {
+	if deck.ShuffleMode == SecureShuffle {
+		return secureRandomSource{}
+	}
+	seed := newSeed()
+	deck.record(Operation{Type: operationType, Seed: &seed})
+	return newSeededRandomSource(seed)
+}
+
+// newSeededRandomSource returns a RandomSource initialized with seed.
+// The same seed always produces the same sequence of random numbers.
+func newSeededRandomSource(seed int64) RandomSource {
+	return rand.New(rand.NewSource(seed))
+}
+
+// newSeed returns a random seed.
+// newSeed relies on crypto/rand so that the seeds of concurrent shuffles are unpredictable and
+// independent from each other, and falls back on the current time if no randomness is available.
+func newSeed() int64 {
+	var seed int64
+	if err := binary.Read(cryptorand.Reader, binary.BigEndian, &seed); err != nil {
+		return time.Now().UnixNano()
+	}
+	return seed
+}
+
+// Cut splits the cards contained in a playable deck after the first position cards, and puts the
+// bottom part on top of the top part.
+// Cut records the cut in History.
+// Cut fails with ErrInvalidCutPosition unless position is between 1 and the number of remaining
+// cards minus one.
+func (deck *PlayableDeck) Cut(position int) error {
+	if position < 1 || position >= len(deck.Cards) {
+		return fmt.Errorf("%w: the deck can only be cut between 1 and %d", ErrInvalidCutPosition, len(deck.Cards)-1)
+	}
+	deck.Cards = append(cloneCards(deck.Cards[position:]), deck.Cards[:position]...)
+	deck.record(Operation{Type: CutOperation, At: position})
+	return nil
+}
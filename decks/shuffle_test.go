@@ -0,0 +1,121 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// chiSquareCriticalValue is the 0.9999 quantile of the chi-square distribution with 25 degrees of
+// freedom, i.e. (6 - 1)^2 for the positions of 6 cards.
+const chiSquareCriticalValue = 60.39
+
+func TestFisherYatesIsUniformWithSecureSource(t *testing.T) {
+	statistic := shufflePositionChiSquare(t, 30000, func(playingCards []cards.PlayingCard, _ int) {
+		fisherYates(playingCards, secureRandomSource{})
+	})
+	assert.Less(t, statistic, chiSquareCriticalValue, "expected uniformly distributed card positions")
+}
+
+func TestFisherYatesIsUniformWithSeededSource(t *testing.T) {
+	statistic := shufflePositionChiSquare(t, 30000, func(playingCards []cards.PlayingCard, trial int) {
+		fisherYates(playingCards, newSeededRandomSource(int64(trial)))
+	})
+	assert.Less(t, statistic, chiSquareCriticalValue, "expected uniformly distributed card positions")
+}
+
+func TestFisherYatesDetectsBias(t *testing.T) {
+	statistic := shufflePositionChiSquare(t, 30000, func(playingCards []cards.PlayingCard, _ int) {
+		for i := range playingCards {
+			j := secureRandomSource{}.Intn(len(playingCards))
+			playingCards[i], playingCards[j] = playingCards[j], playingCards[i]
+		}
+	})
+	assert.Greater(t, statistic, chiSquareCriticalValue, "expected the naive shuffle to be detected as biased")
+}
+
+func TestSecureRandomSourceBounds(t *testing.T) {
+	for n := 1; n <= 64; n++ {
+		value := secureRandomSource{}.Intn(n)
+		assert.True(t, value >= 0 && value < n, "expected a value in [0, %d); got %d", n, value)
+	}
+}
+
+func TestSecureShuffle(t *testing.T) {
+	sortedDeck, _ := NewFrenchDeck([]string{})
+
+	shuffledDeck, err := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, ShuffleMode: SecureShuffle}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.True(t, shuffledDeck.Shuffled, "expected a shuffled deck")
+	assert.Equal(t, SecureShuffle, shuffledDeck.ShuffleMode)
+	assert.Nil(t, shuffledDeck.Seed, "expected no seed for a secure shuffle")
+	assert.NotEqual(t, sortedDeck.Cards, shuffledDeck.Cards, "expected a different order")
+	assert.ElementsMatch(t, sortedDeck.Cards, shuffledDeck.Cards, "expected the same cards")
+}
+
+func TestCut(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	playingDeck.DrawCard(1)
+
+	err := playingDeck.Cut(1)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, fourOfSpades, twoOfSpades}, playingDeck.Cards, "expected the bottom part on top")
+	assert.Equal(t, 3, playingDeck.Remaining)
+	assert.Equal(t, []Operation{{Type: CutOperation, At: 1}}, playingDeck.History, "expected the cut to be recorded")
+
+	for _, position := range []int{-1, 0, 3} {
+		err = playingDeck.Cut(position)
+		assert.ErrorIs(t, err, ErrInvalidCutPosition)
+	}
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, fourOfSpades, twoOfSpades}, playingDeck.Cards, "expected the cards to be untouched")
+	assert.Len(t, playingDeck.History, 1, "expected no invalid cut to be recorded")
+}
+
+func TestShuffleIsRecorded(t *testing.T) {
+	playingDeck := newDiscardTestDeck(t)
+	assert.Nil(t, playingDeck.SetShuffleSequence("riffle x3"), "expected no error")
+	playingDeck.ShuffleWithSeed(42)
+
+	seed := int64(42)
+	assert.Equal(t, []Operation{{Type: ShuffleOperation, Seed: &seed, ShuffleSequence: "riffle x3"}}, playingDeck.History, "expected the shuffle to be recorded")
+	assert.NotSame(t, playingDeck.Seed, playingDeck.History[0].Seed, "expected the recorded seed to be a copy")
+
+	playingDeck.ShuffleMode = SecureShuffle
+	playingDeck.Shuffle()
+	assert.Equal(t, Operation{Type: ShuffleOperation, ShuffleSequence: "riffle x3"}, playingDeck.History[1], "expected the secure shuffle to be recorded without seed")
+}
+
+// shufflePositionChiSquare shuffles a 6-card deck trialCount times with shuffle and returns the
+// chi-square statistic of the positions taken by every card against a uniform distribution.
+func shufflePositionChiSquare(t *testing.T, trialCount int, shuffle func(playingCards []cards.PlayingCard, trial int)) float64 {
+	deck, err := NewFrenchDeck([]string{"AS", "2S", "3S", "4S", "5S", "6S"})
+	if err != nil {
+		t.Fatalf("unable to create the test deck: %s", err)
+	}
+	cardCount := len(deck.Cards)
+	initialPositions := make(map[string]int)
+	for position, card := range deck.Cards {
+		initialPositions[card.Code] = position
+	}
+
+	observations := make([][]int, cardCount)
+	for i := range observations {
+		observations[i] = make([]int, cardCount)
+	}
+	for trial := 0; trial < trialCount; trial++ {
+		playingCards := cloneCards(deck.Cards)
+		shuffle(playingCards, trial)
+		for position, card := range playingCards {
+			observations[initialPositions[card.Code]][position] += 1
+		}
+	}
+
+	expected := float64(trialCount) / float64(cardCount)
+	statistic := 0.0
+	for _, cardObservations := range observations {
+		for _, observed := range cardObservations {
+			statistic += (float64(observed) - expected) * (float64(observed) - expected) / expected
+		}
+	}
+	return statistic
+}
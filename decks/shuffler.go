@@ -0,0 +1,230 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidShuffleSequence is returned when a shuffle sequence cannot be parsed.
+var ErrInvalidShuffleSequence = errors.New("invalid shuffle sequence")
+
+const (
+	// maxShuffleSteps is the maximum number of steps of a ShuffleSequence.
+	maxShuffleSteps = 16
+	// maxShuffleRepeat is the maximum number of times a step of a ShuffleSequence can be repeated.
+	maxShuffleRepeat = 64
+	// defaultPileCount is the number of piles of a PileShuffler if none is requested.
+	defaultPileCount = 4
+	// maxPileCount is the maximum number of piles of a PileShuffler.
+	maxPileCount = 52
+	// overhandPacketBreakOdds is the inverse of the probability for an overhand shuffle to break a
+	// packet between two cards, i.e. packets of 4 cards on average.
+	overhandPacketBreakOdds = 4
+)
+
+// Shuffler is the interface that wraps the method used to shuffle cards.
+//
+// Shuffle rearranges playingCards in place, relying on source for any randomness.
+type Shuffler interface {
+	Shuffle(playingCards []cards.PlayingCard, source RandomSource)
+}
+
+// UniformShuffler shuffles cards with the Fisher–Yates algorithm, so that every permutation of the
+// cards is equally likely.
+type UniformShuffler struct{}
+
+// RiffleShuffler simulates a riffle shuffle according to the Gilbert–Shannon–Reeds model: the cards
+// are cut in two packets following a binomial distribution, then the packets are interleaved by
+// dropping a card from either packet with a probability proportional to its size.
+type RiffleShuffler struct{}
+
+// OverhandShuffler simulates an overhand shuffle: small packets of cards are successively pulled
+// from the top of the cards and dropped on top of each other, reversing the order of the packets.
+type OverhandShuffler struct{}
+
+// PileShuffler simulates a pile shuffle: the cards are dealt one by one into Piles piles, which are
+// then stacked in a random order.
+type PileShuffler struct {
+	Piles int
+}
+
+// CutShuffler simulates a cut: the cards are split at a random position and the bottom part is put
+// on top of the top part.
+type CutShuffler struct{}
+
+// ShuffleStep is the representation of a Shuffler applied Repeat times in a row.
+type ShuffleStep struct {
+	Shuffler Shuffler
+	Repeat   int
+}
+
+// ShuffleSequence is the representation of successive ShuffleStep e.g. seven riffles followed by a cut.
+type ShuffleSequence []ShuffleStep
+
+var (
+	_ Shuffler = UniformShuffler{}
+	_ Shuffler = RiffleShuffler{}
+	_ Shuffler = OverhandShuffler{}
+	_ Shuffler = PileShuffler{}
+	_ Shuffler = CutShuffler{}
+	_ Shuffler = ShuffleSequence{}
+)
+
+// Shuffle rearranges playingCards in place with the Fisher–Yates algorithm.
+func (UniformShuffler) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	fisherYates(playingCards, source)
+}
+
+// Shuffle rearranges playingCards in place with a single Gilbert–Shannon–Reeds riffle.
+func (RiffleShuffler) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	cut := 0
+	for range playingCards {
+		cut += source.Intn(2)
+	}
+	left := cloneCards(playingCards[:cut])
+	right := cloneCards(playingCards[cut:])
+	for i := range playingCards {
+		if len(right) == 0 || (len(left) > 0 && source.Intn(len(left)+len(right)) < len(left)) {
+			playingCards[i], left = left[0], left[1:]
+		} else {
+			playingCards[i], right = right[0], right[1:]
+		}
+	}
+}
+
+// Shuffle rearranges playingCards in place with a single overhand shuffle, breaking packets with a
+// probability of 1/overhandPacketBreakOdds between two cards.
+func (OverhandShuffler) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	shuffledCards := make([]cards.PlayingCard, 0, len(playingCards))
+	packetEnd := len(playingCards)
+	for i := len(playingCards) - 1; i >= 0; i-- {
+		if i == 0 || source.Intn(overhandPacketBreakOdds) == 0 {
+			shuffledCards = append(shuffledCards, playingCards[i:packetEnd]...)
+			packetEnd = i
+		}
+	}
+	copy(playingCards, shuffledCards)
+}
+
+// Shuffle rearranges playingCards in place with a single pile shuffle.
+// defaultPileCount piles are used if Piles is not positive.
+func (shuffler PileShuffler) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	pileCount := shuffler.Piles
+	if pileCount <= 0 {
+		pileCount = defaultPileCount
+	}
+	piles := make([][]cards.PlayingCard, pileCount)
+	for i, card := range playingCards {
+		pile := i % pileCount
+		piles[pile] = append([]cards.PlayingCard{card}, piles[pile]...)
+	}
+	for i := len(piles) - 1; i > 0; i-- {
+		j := source.Intn(i + 1)
+		piles[i], piles[j] = piles[j], piles[i]
+	}
+	shuffledCards := make([]cards.PlayingCard, 0, len(playingCards))
+	for _, pile := range piles {
+		shuffledCards = append(shuffledCards, pile...)
+	}
+	copy(playingCards, shuffledCards)
+}
+
+// Shuffle rearranges playingCards in place with a single cut at a random position, leaving at least
+// one card in each part.
+func (CutShuffler) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	if len(playingCards) < 2 {
+		return
+	}
+	cut := 1 + source.Intn(len(playingCards)-1)
+	shuffledCards := append(cloneCards(playingCards[cut:]), playingCards[:cut]...)
+	copy(playingCards, shuffledCards)
+}
+
+// Shuffle rearranges playingCards in place by applying every step of the sequence in order.
+// An empty sequence applies a UniformShuffler.
+func (sequence ShuffleSequence) Shuffle(playingCards []cards.PlayingCard, source RandomSource) {
+	if len(sequence) == 0 {
+		UniformShuffler{}.Shuffle(playingCards, source)
+		return
+	}
+	for _, step := range sequence {
+		for i := 0; i < step.Repeat; i++ {
+			step.Shuffler.Shuffle(playingCards, source)
+		}
+	}
+}
+
+// ParseShuffleSequence parses and returns the ShuffleSequence described by specification.
+// specification is a comma separated list of steps formatted as "<shuffler>[:<piles>] [x<repeat>]"
+// e.g. "riffle x7, cut" or "pile:5, overhand x3". The shufflers are uniform, riffle, overhand, pile
+// and cut, and <piles> only applies to pile.
+// An empty specification returns an empty ShuffleSequence.
+// ParseShuffleSequence fails with ErrInvalidShuffleSequence if specification cannot be parsed.
+func ParseShuffleSequence(specification string) (ShuffleSequence, error) {
+	if strings.TrimSpace(specification) == "" {
+		return ShuffleSequence{}, nil
+	}
+	rawSteps := strings.Split(specification, ",")
+	if len(rawSteps) > maxShuffleSteps {
+		return nil, fmt.Errorf("%w: at most %d steps are allowed", ErrInvalidShuffleSequence, maxShuffleSteps)
+	}
+	sequence := make(ShuffleSequence, 0, len(rawSteps))
+	for _, rawStep := range rawSteps {
+		step, err := parseShuffleStep(rawStep)
+		if err != nil {
+			return nil, err
+		}
+		sequence = append(sequence, step)
+	}
+	return sequence, nil
+}
+
+// parseShuffleStep parses and returns the ShuffleStep described by rawStep, formatted as
+// "<shuffler>[:<piles>] [x<repeat>]".
+// parseShuffleStep fails with ErrInvalidShuffleSequence if rawStep cannot be parsed.
+func parseShuffleStep(rawStep string) (ShuffleStep, error) {
+	fields := strings.Fields(strings.ToLower(rawStep))
+	if len(fields) == 0 || len(fields) > 2 {
+		return ShuffleStep{}, fmt.Errorf("%w: malformed step '%s'", ErrInvalidShuffleSequence, strings.TrimSpace(rawStep))
+	}
+	repeat := 1
+	if len(fields) == 2 {
+		parsedRepeat, err := strconv.Atoi(strings.TrimPrefix(fields[1], "x"))
+		if !strings.HasPrefix(fields[1], "x") || err != nil || parsedRepeat < 1 || parsedRepeat > maxShuffleRepeat {
+			return ShuffleStep{}, fmt.Errorf("%w: the repeat of step '%s' must be formatted as x<1-%d>", ErrInvalidShuffleSequence, strings.TrimSpace(rawStep), maxShuffleRepeat)
+		}
+		repeat = parsedRepeat
+	}
+
+	name, argument, hasArgument := strings.Cut(fields[0], ":")
+	if hasArgument && name != "pile" {
+		return ShuffleStep{}, fmt.Errorf("%w: shuffler '%s' takes no argument", ErrInvalidShuffleSequence, name)
+	}
+	var shuffler Shuffler
+	switch name {
+	case "uniform":
+		shuffler = UniformShuffler{}
+	case "riffle":
+		shuffler = RiffleShuffler{}
+	case "overhand":
+		shuffler = OverhandShuffler{}
+	case "cut":
+		shuffler = CutShuffler{}
+	case "pile":
+		pileCount := defaultPileCount
+		if hasArgument {
+			parsedPileCount, err := strconv.Atoi(argument)
+			if err != nil || parsedPileCount < 2 || parsedPileCount > maxPileCount {
+				return ShuffleStep{}, fmt.Errorf("%w: the pile count must be between 2 and %d", ErrInvalidShuffleSequence, maxPileCount)
+			}
+			pileCount = parsedPileCount
+		}
+		shuffler = PileShuffler{Piles: pileCount}
+	default:
+		return ShuffleStep{}, fmt.Errorf("%w: unsupported shuffler '%s'", ErrInvalidShuffleSequence, name)
+	}
+	return ShuffleStep{Shuffler: shuffler, Repeat: repeat}, nil
+}
@@ -0,0 +1,174 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// constantRandomSource is a RandomSource always returning the same number, bounded by n.
+type constantRandomSource int
+
+func (source constantRandomSource) Intn(n int) int {
+	if int(source) >= n {
+		return n - 1
+	}
+	return int(source)
+}
+
+func TestShufflersKeepCards(t *testing.T) {
+	sortedDeck, _ := NewFrenchDeck([]string{})
+	tests := map[string]Shuffler{
+		"uniform":  UniformShuffler{},
+		"riffle":   RiffleShuffler{},
+		"overhand": OverhandShuffler{},
+		"pile":     PileShuffler{Piles: 5},
+		"cut":      CutShuffler{},
+		"sequence": ShuffleSequence{{Shuffler: RiffleShuffler{}, Repeat: 7}, {Shuffler: CutShuffler{}, Repeat: 1}},
+	}
+	for name, shuffler := range tests {
+		t.Run(name, func(t *testing.T) {
+			playingCards := cloneCards(sortedDeck.Cards)
+			shuffler.Shuffle(playingCards, newSeededRandomSource(42))
+			assert.NotEqual(t, sortedDeck.Cards, playingCards, "expected a different order")
+			assert.ElementsMatch(t, sortedDeck.Cards, playingCards, "expected the same cards")
+		})
+	}
+}
+
+func TestShufflersHandleFewCards(t *testing.T) {
+	tests := map[string]Shuffler{
+		"uniform":  UniformShuffler{},
+		"riffle":   RiffleShuffler{},
+		"overhand": OverhandShuffler{},
+		"pile":     PileShuffler{},
+		"cut":      CutShuffler{},
+	}
+	for name, shuffler := range tests {
+		t.Run(name, func(t *testing.T) {
+			shuffler.Shuffle([]cards.PlayingCard{}, newSeededRandomSource(42))
+			playingCards := []cards.PlayingCard{aceOfSpades}
+			shuffler.Shuffle(playingCards, newSeededRandomSource(42))
+			assert.Equal(t, []cards.PlayingCard{aceOfSpades}, playingCards, "expected the single card to stay in place")
+		})
+	}
+}
+
+func TestCutShuffler(t *testing.T) {
+	playingCards := []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}
+	CutShuffler{}.Shuffle(playingCards, constantRandomSource(1))
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, fourOfSpades, aceOfSpades, twoOfSpades}, playingCards, "expected the cards to be cut after the second card")
+}
+
+func TestOverhandShuffler(t *testing.T) {
+	playingCards := []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}
+	OverhandShuffler{}.Shuffle(playingCards, constantRandomSource(0))
+	assert.Equal(t, []cards.PlayingCard{fourOfSpades, threeOfSpades, twoOfSpades, aceOfSpades}, playingCards, "expected single card packets to be reversed")
+
+	playingCards = []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}
+	OverhandShuffler{}.Shuffle(playingCards, constantRandomSource(1))
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}, playingCards, "expected a single packet to be kept in order")
+}
+
+func TestPileShuffler(t *testing.T) {
+	playingCards := []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades, fourOfSpades}
+	PileShuffler{Piles: 2}.Shuffle(playingCards, constantRandomSource(1))
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades, fourOfSpades, twoOfSpades}, playingCards, "expected the piles to be stacked in order")
+}
+
+func TestRiffleShufflerKeepsPacketOrder(t *testing.T) {
+	sortedDeck, _ := NewFrenchDeck([]string{})
+	playingCards := cloneCards(sortedDeck.Cards)
+	RiffleShuffler{}.Shuffle(playingCards, newSeededRandomSource(42))
+	positions := make(map[string]int)
+	for position, card := range playingCards {
+		positions[card.Code] = position
+	}
+
+	risingSequences := 1
+	for i := 1; i < len(sortedDeck.Cards); i++ {
+		if positions[sortedDeck.Cards[i].Code] < positions[sortedDeck.Cards[i-1].Code] {
+			risingSequences += 1
+		}
+	}
+	assert.LessOrEqual(t, risingSequences, 2, "expected a single riffle to interleave two rising sequences")
+}
+
+func TestRepeatedRiffleShufflerIsUniform(t *testing.T) {
+	sequence, _ := ParseShuffleSequence("riffle x12")
+	statistic := shufflePositionChiSquare(t, 30000, func(playingCards []cards.PlayingCard, trial int) {
+		sequence.Shuffle(playingCards, newSeededRandomSource(int64(trial)))
+	})
+	assert.Less(t, statistic, chiSquareCriticalValue, "expected uniformly distributed card positions")
+}
+
+func TestSingleRiffleShufflerIsBiased(t *testing.T) {
+	statistic := shufflePositionChiSquare(t, 30000, func(playingCards []cards.PlayingCard, trial int) {
+		RiffleShuffler{}.Shuffle(playingCards, newSeededRandomSource(int64(trial)))
+	})
+	assert.Greater(t, statistic, chiSquareCriticalValue, "expected a single riffle to be detected as biased")
+}
+
+func TestParseShuffleSequence(t *testing.T) {
+	tests := []struct {
+		specification string
+		expected      ShuffleSequence
+	}{
+		{"", ShuffleSequence{}},
+		{"uniform", ShuffleSequence{{Shuffler: UniformShuffler{}, Repeat: 1}}},
+		{"riffle x7, cut", ShuffleSequence{{Shuffler: RiffleShuffler{}, Repeat: 7}, {Shuffler: CutShuffler{}, Repeat: 1}}},
+		{" Overhand X3 ,PILE:5 ", ShuffleSequence{{Shuffler: OverhandShuffler{}, Repeat: 3}, {Shuffler: PileShuffler{Piles: 5}, Repeat: 1}}},
+		{"pile", ShuffleSequence{{Shuffler: PileShuffler{Piles: defaultPileCount}, Repeat: 1}}},
+	}
+	for _, test := range tests {
+		sequence, err := ParseShuffleSequence(test.specification)
+		assert.Nil(t, err, "expected no error for '%s'", test.specification)
+		assert.Equal(t, test.expected, sequence, "expected the sequence of '%s'", test.specification)
+	}
+}
+
+func TestParseInvalidShuffleSequence(t *testing.T) {
+	for _, specification := range []string{
+		"shake",
+		"riffle,",
+		"riffle 7",
+		"riffle x0",
+		"riffle x65",
+		"riffle x7 cut",
+		"cut:2",
+		"pile:1",
+		"pile:many",
+		"cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut, cut",
+	} {
+		_, err := ParseShuffleSequence(specification)
+		assert.True(t, errors.Is(err, ErrInvalidShuffleSequence), "expected an invalid shuffle sequence for '%s'", specification)
+	}
+}
+
+func TestShuffleWithSequence(t *testing.T) {
+	sortedDeck, _ := NewFrenchDeck([]string{})
+	creationRequest := CreationRequest{PlayingType: cards.French, Shuffled: true, ShuffleSequence: "riffle x7, cut"}
+	seed := int64(42)
+	creationRequest.Seed = &seed
+
+	firstDeck, err := CreateDeck(creationRequest, nil)
+	assert.Nil(t, err, "expected no error")
+	secondDeck, _ := CreateDeck(creationRequest, nil)
+	uniformDeck, _ := CreateDeck(CreationRequest{PlayingType: cards.French, Shuffled: true, Seed: &seed}, nil)
+
+	assert.Equal(t, "riffle x7, cut", firstDeck.ShuffleSequence)
+	assert.Equal(t, firstDeck.Cards, secondDeck.Cards, "expected the same order out of the same seed")
+	assert.NotEqual(t, uniformDeck.Cards, firstDeck.Cards, "expected the sequence to be applied")
+	assert.ElementsMatch(t, sortedDeck.Cards, firstDeck.Cards, "expected the same cards")
+}
+
+func TestSetShuffleSequence(t *testing.T) {
+	deck := newDiscardTestDeck(t)
+	assert.Nil(t, deck.SetShuffleSequence("overhand x5"), "expected no error")
+	assert.Equal(t, "overhand x5", deck.ShuffleSequence)
+
+	err := deck.SetShuffleSequence("shake")
+	assert.True(t, errors.Is(err, ErrInvalidShuffleSequence), "expected an invalid shuffle sequence")
+	assert.Equal(t, "overhand x5", deck.ShuffleSequence, "expected the shuffle sequence to be unchanged")
+}
@@ -0,0 +1,79 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// SpanishDeck is the representation of a deck containing Spanish-suited playable cards.
+type SpanishDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &SpanishDeck{}
+
+const (
+	// Spanish40 is the variant of a SpanishDeck leaving out the 8 and the 9 of every suit.
+	Spanish40 = "40"
+	// Spanish48 is the variant of a SpanishDeck containing every value of every suit.
+	Spanish48 = "48"
+)
+
+// NewSpanishDeck creates and returns a SpanishDeck according to the Spanish-suited card standards
+// and to the provided variant, Spanish48 if variant is empty.
+// A successful NewSpanishDeck returns err == nil.
+func NewSpanishDeck(requestedCardCodes []string, variant string) (*SpanishDeck, error) {
+	playingCards, err := generateSpanishDeckPlayingCards(requestedCardCodes, variant)
+	if err != nil {
+		return nil, fmt.Errorf("spanish playing cards creation failure on deck generation: %w", err)
+	}
+	return &SpanishDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// validateSpanishVariant ensures variant is a supported variant of a SpanishDeck.
+func validateSpanishVariant(variant string) error {
+	switch variant {
+	case "", Spanish40, Spanish48:
+		return nil
+	}
+	return fmt.Errorf("unsupported spanish deck variant '%s'", variant)
+}
+
+// generateSpanishDeckPlayingCards generates and return a slice of cards.PlayingCard according to
+// the Spanish-suited card standards and to variant.
+// generateSpanishDeckPlayingCards creates a standard set of Spanish-suited cards if
+// requestedCardCodes is empty.
+// If requestedCardCodes contains unrecognizable card codes according to the variant, an error is
+// returned.
+// A successful generateSpanishDeckPlayingCards returns err == nil.
+func generateSpanishDeckPlayingCards(requestedCardCodes []string, variant string) ([]cards.PlayingCard, error) {
+	if err := validateSpanishVariant(variant); err != nil {
+		return nil, err
+	}
+	var playingCards []cards.PlayingCard
+	for _, suit := range cards.SpanishCardSuits {
+		for _, value := range cards.SpanishCardValues {
+			if variant == Spanish40 && (value == "8" || value == "9") {
+				continue
+			}
+			card, err := cards.NewSpanishCard(suit.String(), value)
+			if err != nil {
+				return nil, errors.New("spanish playing cards creation failure on deck generation")
+			}
+			playingCards = append(playingCards, card.PlayingCard)
+		}
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCards(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
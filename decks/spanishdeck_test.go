@@ -0,0 +1,84 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewSpanishDeck(t *testing.T) {
+	testRecords := []struct {
+		variant       string
+		expectedCount int
+		expectedNines int
+	}{
+		{"", 48, 4},
+		{Spanish48, 48, 4},
+		{Spanish40, 40, 0},
+	}
+	for _, testRecord := range testRecords {
+		actualDeck, err := NewSpanishDeck([]string{}, testRecord.variant)
+		assert.Nil(t, err, "expected no error when generating the deck")
+		assert.Equal(t, testRecord.expectedCount, actualDeck.Remaining)
+		assert.Len(t, actualDeck.Cards, testRecord.expectedCount)
+		assert.False(t, actualDeck.Shuffled)
+
+		nines := 0
+		codes := make(map[string]bool)
+		for _, card := range actualDeck.Cards {
+			if card.Value == "9" {
+				nines += 1
+			}
+			codes[card.Code] = true
+		}
+		assert.Equal(t, testRecord.expectedNines, nines, "expected %d nines in the '%s' variant", testRecord.expectedNines, testRecord.variant)
+		assert.Len(t, codes, testRecord.expectedCount, "expected unique card codes")
+	}
+
+	firstCard, _ := cards.NewSpanishCard(cards.Oros.String(), "AS")
+	lastCard, _ := cards.NewSpanishCard(cards.Bastos.String(), "REY")
+	actualDeck, _ := NewSpanishDeck([]string{}, Spanish40)
+	assert.Equal(t, firstCard.PlayingCard, actualDeck.Cards[0])
+	assert.Equal(t, lastCard.PlayingCard, actualDeck.Cards[39])
+}
+
+func TestGenerateSpanishDeckPlayingCards(t *testing.T) {
+	testRecords := []struct {
+		requestedCardCodes []string
+		variant            string
+		expectedCardCodes  []string
+	}{
+		{[]string{"AO", "CE", "RB", "7C"}, "", []string{"AO", "CE", "RB", "7C"}},
+		{[]string{"9O", ""}, Spanish48, []string{"9O"}},
+		{[]string{"9O"}, Spanish40, nil},
+		{[]string{"AS"}, "", nil},
+		{[]string{}, "52", nil},
+	}
+	for _, testRecord := range testRecords {
+		playingCards, err := generateSpanishDeckPlayingCards(testRecord.requestedCardCodes, testRecord.variant)
+		if testRecord.expectedCardCodes == nil {
+			assert.Nil(t, playingCards, "expected no playing cards")
+			assert.NotNil(t, err, "expected an error when generating the deck")
+			continue
+		}
+		assert.Nil(t, err, "expected no error")
+		assert.Len(t, playingCards, len(testRecord.expectedCardCodes))
+		for i, card := range playingCards {
+			assert.Equal(t, testRecord.expectedCardCodes[i], card.Code, "requested cards code and expected cards code do not match")
+		}
+	}
+}
+
+func TestCreateSpanishDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.Spanish, Variant: Spanish40, Count: 2}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Spanish, playingDeck.Type)
+	assert.Equal(t, Spanish40, playingDeck.Variant)
+	assert.Equal(t, 80, playingDeck.Remaining)
+	assert.Equal(t, 2, playingDeck.Cards[79].DeckIndex)
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Spanish, Variant: "52"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.French, Variant: Spanish40}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
@@ -0,0 +1,65 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// TarotDeck is the representation of a deck containing French Tarot playable cards.
+type TarotDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &TarotDeck{}
+
+// NewTarotDeck creates and returns a TarotDeck according to the French Tarot card standards.
+// A successful NewTarotDeck returns err == nil.
+func NewTarotDeck(requestedCardCodes []string) (*TarotDeck, error) {
+	playingCards, err := generateTarotDeckPlayingCards(requestedCardCodes)
+	if err != nil {
+		return nil, fmt.Errorf("tarot playing cards creation failure on deck generation: %w", err)
+	}
+	return &TarotDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// generateTarotDeckPlayingCards generates and return a slice of cards.PlayingCard according to
+// the French Tarot card standards.
+// generateTarotDeckPlayingCards creates the 78 cards of a Tarot deck if requestedCardCodes is
+// empty: the suited cards, followed by the trumps and the Excuse.
+// If requestedCardCodes contains unrecognizable card codes according to the French Tarot card
+// standards, an error is returned.
+// A successful generateTarotDeckPlayingCards returns err == nil.
+func generateTarotDeckPlayingCards(requestedCardCodes []string) ([]cards.PlayingCard, error) {
+	var playingCards []cards.PlayingCard
+	for _, suit := range cards.FrenchCardSuits {
+		for _, value := range cards.TarotCardValues {
+			card, err := cards.NewTarotCard(suit.String(), value)
+			if err != nil {
+				return nil, errors.New("tarot playing cards creation failure on deck generation")
+			}
+			playingCards = append(playingCards, card.PlayingCard)
+		}
+	}
+	for number := 1; number <= cards.TarotTrumpCount; number++ {
+		card, err := cards.NewTarotTrump(number)
+		if err != nil {
+			return nil, errors.New("tarot playing cards creation failure on deck generation")
+		}
+		playingCards = append(playingCards, card.PlayingCard)
+	}
+	playingCards = append(playingCards, cards.NewTarotExcuse().PlayingCard)
+
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCards(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
@@ -0,0 +1,53 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewTarotDeck(t *testing.T) {
+	actualDeck, err := NewTarotDeck([]string{})
+	assert.Nil(t, err, "expected no error when generating the deck")
+	assert.Equal(t, 78, actualDeck.Remaining)
+	assert.False(t, actualDeck.Shuffled)
+
+	codes := make(map[string]bool)
+	trumps := 0
+	for _, card := range actualDeck.Cards {
+		assert.False(t, codes[card.Code], "expected a unique code; got '%s' twice", card.Code)
+		codes[card.Code] = true
+		if card.Suit == cards.TarotTrumpSuit {
+			trumps += 1
+		}
+	}
+	assert.Equal(t, cards.TarotTrumpCount, trumps)
+	assert.Equal(t, "AS", actualDeck.Cards[0].Code)
+	assert.Equal(t, "T1", actualDeck.Cards[56].Code)
+	assert.Equal(t, "T21", actualDeck.Cards[76].Code)
+	assert.Equal(t, "EX", actualDeck.Cards[77].Code)
+}
+
+func TestGenerateTarotDeckPlayingCards(t *testing.T) {
+	playingCards, err := generateTarotDeckPlayingCards([]string{"CH", "KH", "T21", "EX", "T1"})
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, playingCards, 5)
+	assert.Equal(t, cards.TarotKnightValue, playingCards[0].Value)
+	assert.Equal(t, "KING", playingCards[1].Value)
+	assert.Equal(t, "21", playingCards[2].Value)
+	assert.Equal(t, cards.TarotExcuseValue, playingCards[3].Value)
+
+	playingCards, err = generateTarotDeckPlayingCards([]string{"T22"})
+	assert.Nil(t, playingCards, "expected no playing cards")
+	assert.NotNil(t, err, "expected an error when generating the deck")
+}
+
+func TestCreateTarotDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.Tarot}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Tarot, playingDeck.Type)
+	assert.Equal(t, 78, playingDeck.Remaining)
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Tarot, Variant: "54"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
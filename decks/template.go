@@ -0,0 +1,188 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidTemplate is returned when a Template cannot be used to create decks.
+var ErrInvalidTemplate = errors.New("invalid template")
+
+const (
+	// MaxTemplateCopies is the maximum number of copies of a card of a Template.
+	MaxTemplateCopies = 8
+	// MaxTemplateCardCount is the maximum number of cards of a deck created from a Template,
+	// copies included.
+	MaxTemplateCardCount = 1000
+)
+
+// Template is the representation of a user-defined type of deck.
+// The cards of a Template are the combination of every value of Values with every suit of Suits,
+// coded like a PlayingCard, followed by Cards.
+// Copies is the number of copies of every card of the combination, a single copy if Copies is zero.
+// Metadata holds arbitrary information describing the Template e.g. the game it was designed for.
+type Template struct {
+	ID       uuid.UUID         `json:"template_id"`
+	Name     string            `json:"name"`
+	Suits    []string          `json:"suits,omitempty"`
+	Values   []string          `json:"values,omitempty"`
+	Copies   int               `json:"copies,omitempty"`
+	Cards    []TemplateCard    `json:"cards,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// TemplateCard is the representation of a card explicitly defined by a Template.
+// Code is the code of the card, computed like the code of a PlayingCard if empty.
+// Copies is the number of copies of the card, a single copy if Copies is zero.
+// Attributes holds arbitrary information carried by the card e.g. its cost or its effect.
+type TemplateCard struct {
+	Suit       string            `json:"suit"`
+	Value      string            `json:"value"`
+	Code       string            `json:"code,omitempty"`
+	Copies     int               `json:"copies,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// NewTemplate validates template and returns a copy of it associated with a new ID.
+// NewTemplate fails with ErrInvalidTemplate if template is not valid.
+func NewTemplate(template Template) (*Template, error) {
+	if _, err := template.PlayingCards(); err != nil {
+		return nil, err
+	}
+	newTemplate := template.Clone()
+	newTemplate.ID = uuid.New()
+	return newTemplate, nil
+}
+
+// PlayingCards generates and returns the cards of a deck created from the Template, every copy of
+// a card being marked with the 1-based index of its copy.
+// PlayingCards fails with ErrInvalidTemplate if the Template has no name or no card, if a suit, a
+// value or a code is empty or contains spaces or commas, if a number of copies is out of bounds,
+// if two different cards share a code, or if the deck would exceed MaxTemplateCardCount cards.
+func (template *Template) PlayingCards() ([]cards.PlayingCard, error) {
+	if strings.TrimSpace(template.Name) == "" {
+		return nil, fmt.Errorf("%w: the name must not be empty", ErrInvalidTemplate)
+	}
+	templateCards := make([]TemplateCard, 0, len(template.Suits)*len(template.Values)+len(template.Cards))
+	for _, suit := range template.Suits {
+		for _, value := range template.Values {
+			templateCards = append(templateCards, TemplateCard{Suit: suit, Value: value, Copies: template.Copies})
+		}
+	}
+	templateCards = append(templateCards, template.Cards...)
+	if len(templateCards) == 0 {
+		return nil, fmt.Errorf("%w: the template must define cards", ErrInvalidTemplate)
+	}
+
+	var playingCards []cards.PlayingCard
+	codes := make(map[string]bool, len(templateCards))
+	for _, templateCard := range templateCards {
+		card, err := templateCard.playingCard()
+		if err != nil {
+			return nil, err
+		}
+		if codes[card.Code] {
+			return nil, fmt.Errorf("%w: the code '%s' is shared by several cards", ErrInvalidTemplate, card.Code)
+		}
+		codes[card.Code] = true
+
+		copies := templateCard.Copies
+		if copies < 0 || copies > MaxTemplateCopies {
+			return nil, fmt.Errorf("%w: the copies of '%s' must be between 1 and %d", ErrInvalidTemplate, card.Code, MaxTemplateCopies)
+		}
+		if copies == 0 {
+			copies = 1
+		}
+		if len(playingCards)+copies > MaxTemplateCardCount {
+			return nil, fmt.Errorf("%w: the template must not exceed %d cards", ErrInvalidTemplate, MaxTemplateCardCount)
+		}
+		playingCards = append(playingCards, copyPlayingCards([]cards.PlayingCard{card}, copies)...)
+	}
+	return playingCards, nil
+}
+
+// playingCard returns the cards.PlayingCard defined by a TemplateCard.
+// playingCard fails with ErrInvalidTemplate if the suit, the value or the code of the card is
+// empty or contains spaces or commas.
+func (templateCard TemplateCard) playingCard() (cards.PlayingCard, error) {
+	for field, text := range map[string]string{"suit": templateCard.Suit, "value": templateCard.Value} {
+		if !isTemplateText(text) {
+			return cards.PlayingCard{}, fmt.Errorf("%w: invalid card %s '%s'", ErrInvalidTemplate, field, text)
+		}
+	}
+	card, err := cards.NewPlayingCard(templateCard.Suit, templateCard.Value)
+	if err != nil {
+		return cards.PlayingCard{}, fmt.Errorf("%w: %s", ErrInvalidTemplate, err.Error())
+	}
+	if templateCard.Code != "" {
+		if !isTemplateText(templateCard.Code) {
+			return cards.PlayingCard{}, fmt.Errorf("%w: invalid card code '%s'", ErrInvalidTemplate, templateCard.Code)
+		}
+		card.Code = templateCard.Code
+	} else if !utf8.ValidString(card.Code) {
+		return cards.PlayingCard{}, fmt.Errorf("%w: the code of the card '%s' of '%s' must be provided", ErrInvalidTemplate, templateCard.Value, templateCard.Suit)
+	}
+	card.Attributes = cloneAttributes(templateCard.Attributes)
+	return *card, nil
+}
+
+// Clone returns a deep copy of the Template.
+func (template *Template) Clone() *Template {
+	clone := *template
+	clone.Suits = append([]string(nil), template.Suits...)
+	clone.Values = append([]string(nil), template.Values...)
+	if template.Cards != nil {
+		clone.Cards = make([]TemplateCard, len(template.Cards))
+		for i, templateCard := range template.Cards {
+			clone.Cards[i] = templateCard
+			clone.Cards[i].Attributes = cloneAttributes(templateCard.Attributes)
+		}
+	}
+	clone.Metadata = cloneAttributes(template.Metadata)
+	return &clone
+}
+
+// CreateDeckFromTemplate creates a PlayableDeck based on the provided creationRequest, out of the
+// cards of template, restricted to requestedCardCodes if any.
+// CreateDeckFromTemplate fails with ErrInvalidCreationRequest if creationRequest is not valid, or
+// with ErrInvalidTemplate if template is not valid.
+func CreateDeckFromTemplate(creationRequest CreationRequest, template *Template, requestedCardCodes []string) (*PlayableDeck, error) {
+	creationRequest.PlayingType = cards.Custom
+	creationRequest.TemplateID = template.ID.String()
+	return createDeck(creationRequest, func() ([]cards.PlayingCard, error) {
+		playingCards, err := template.PlayingCards()
+		if err != nil {
+			return nil, err
+		}
+		if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+			return selectRequestedCopies(playingCards, refinedRequestedCardCodes)
+		}
+		return playingCards, nil
+	})
+}
+
+// isTemplateText returns true if text is valid UTF-8, is not empty and contains neither spaces
+// nor commas, so that it can be used in the cards query parameter.
+func isTemplateText(text string) bool {
+	return text != "" && utf8.ValidString(text) && strings.IndexFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	}) < 0
+}
+
+// cloneAttributes returns a copy of attributes.
+// cloneAttributes returns nil if attributes is nil.
+func cloneAttributes(attributes map[string]string) map[string]string {
+	if attributes == nil {
+		return nil
+	}
+	clonedAttributes := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		clonedAttributes[key] = value
+	}
+	return clonedAttributes
+}
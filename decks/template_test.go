@@ -0,0 +1,110 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func newTestTemplate() Template {
+	return Template{
+		Name:   "Lost Cities",
+		Suits:  []string{"RED", "BLUE"},
+		Values: []string{"2", "3", "WAGER"},
+		Cards: []TemplateCard{
+			{Suit: "SPECIAL", Value: "DRAGON", Code: "DR", Copies: 2, Attributes: map[string]string{"cost": "3"}},
+		},
+		Metadata: map[string]string{"players": "2"},
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	template, err := NewTemplate(newTestTemplate())
+	assert.Nil(t, err, "expected no error")
+	assert.NotEmpty(t, template.ID, "expected a template ID")
+	assert.Equal(t, "Lost Cities", template.Name)
+
+	otherTemplate, _ := NewTemplate(newTestTemplate())
+	assert.NotEqual(t, template.ID, otherTemplate.ID, "expected distinct template IDs")
+}
+
+func TestTemplatePlayingCards(t *testing.T) {
+	template := newTestTemplate()
+
+	playingCards, err := template.PlayingCards()
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, 8, len(playingCards))
+	for i, expectedCode := range []string{"2R", "3R", "WR", "2B", "3B", "WB", "DR", "DR"} {
+		assert.Equal(t, expectedCode, playingCards[i].Code, "expected the suits and values to be combined before the explicit cards")
+	}
+	assert.Equal(t, "WAGER", playingCards[2].Value)
+	assert.Equal(t, 1, playingCards[6].CopyIndex, "expected the copies to be traceable")
+	assert.Equal(t, 2, playingCards[7].CopyIndex, "expected the copies to be traceable")
+	assert.Equal(t, map[string]string{"cost": "3"}, playingCards[7].Attributes)
+}
+
+func TestInvalidTemplate(t *testing.T) {
+	testRecords := []struct {
+		description string
+		update      func(template *Template)
+	}{
+		{"no name", func(template *Template) { template.Name = " " }},
+		{"no card", func(template *Template) { template.Suits, template.Cards = nil, nil }},
+		{"empty value", func(template *Template) { template.Values = append(template.Values, "") }},
+		{"spaced suit", func(template *Template) { template.Suits = append(template.Suits, "DARK GREEN") }},
+		{"comma in code", func(template *Template) { template.Cards[0].Code = "D,R" }},
+		{"shared code", func(template *Template) { template.Values = append(template.Values, "WILD") }},
+		{"negative copies", func(template *Template) { template.Copies = -1 }},
+		{"too many copies", func(template *Template) { template.Cards[0].Copies = MaxTemplateCopies + 1 }},
+		{"too many cards", func(template *Template) {
+			template.Suits, template.Cards = nil, nil
+			for i := 0; i <= MaxTemplateCardCount/MaxTemplateCopies; i++ {
+				code := "C" + strconv.Itoa(i)
+				template.Cards = append(template.Cards, TemplateCard{Suit: "CARD", Value: code, Code: code, Copies: MaxTemplateCopies})
+			}
+		}},
+	}
+	for _, testRecord := range testRecords {
+		t.Run(testRecord.description, func(t *testing.T) {
+			template := newTestTemplate()
+			testRecord.update(&template)
+
+			_, err := NewTemplate(template)
+			assert.ErrorIs(t, err, ErrInvalidTemplate)
+		})
+	}
+}
+
+func TestTemplateClone(t *testing.T) {
+	template, _ := NewTemplate(newTestTemplate())
+
+	clone := template.Clone()
+	assert.Equal(t, template, clone, "expected identical templates")
+	clone.Cards[0].Attributes["cost"] = "4"
+	clone.Metadata["players"] = "4"
+	clone.Suits[0] = "GREEN"
+	assert.Equal(t, "3", template.Cards[0].Attributes["cost"], "expected the original template to be untouched")
+	assert.Equal(t, "2", template.Metadata["players"], "expected the original template to be untouched")
+	assert.Equal(t, "RED", template.Suits[0], "expected the original template to be untouched")
+}
+
+func TestCreateDeckFromTemplate(t *testing.T) {
+	template, _ := NewTemplate(newTestTemplate())
+
+	playingDeck, err := CreateDeckFromTemplate(CreationRequest{Count: 2}, template, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Custom, playingDeck.Type)
+	assert.Equal(t, template.ID.String(), playingDeck.TemplateID)
+	assert.Equal(t, 16, playingDeck.Remaining)
+
+	partialDeck, err := CreateDeckFromTemplate(CreationRequest{Shuffled: true}, template, []string{"DR", "2B"})
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, 3, partialDeck.Remaining, "expected every copy of the requested cards")
+
+	_, err = CreateDeckFromTemplate(CreationRequest{}, template, []string{"AS"})
+	assert.ErrorIs(t, err, ErrUnknownCardCode)
+
+	_, err = CreateDeckFromTemplate(CreationRequest{Variant: "40"}, template, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
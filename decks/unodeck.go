@@ -0,0 +1,70 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+)
+
+// UnoDeck is the representation of a deck containing Uno playable cards.
+type UnoDeck struct {
+	PlayableDeck
+}
+
+var _ Deck = &UnoDeck{}
+
+// NewUnoDeck creates and returns an UnoDeck according to the Uno card standards.
+// A successful NewUnoDeck returns err == nil.
+func NewUnoDeck(requestedCardCodes []string) (*UnoDeck, error) {
+	playingCards, err := generateUnoDeckPlayingCards(requestedCardCodes)
+	if err != nil {
+		return nil, fmt.Errorf("uno playing cards creation failure on deck generation: %w", err)
+	}
+	return &UnoDeck{
+		PlayableDeck: PlayableDeck{
+			ID:        uuid.New(),
+			Cards:     playingCards,
+			Shuffled:  false,
+			Remaining: len(playingCards),
+		},
+	}, nil
+}
+
+// generateUnoDeckPlayingCards generates and return a slice of cards.PlayingCard according to the
+// Uno card standards.
+// generateUnoDeckPlayingCards creates the 108 cards of an Uno deck if requestedCardCodes is empty:
+// the colored cards, followed by the wild cards.
+// The copies of a card share its code and are marked with the 1-based index of their copy, the 0
+// of every color being a single copy. Every copy of a requested card is part of the partial deck.
+// If requestedCardCodes contains unrecognizable card codes according to the Uno card standards,
+// an error is returned.
+// A successful generateUnoDeckPlayingCards returns err == nil.
+func generateUnoDeckPlayingCards(requestedCardCodes []string) ([]cards.PlayingCard, error) {
+	var unoCards []*cards.UnoCard
+	for _, color := range cards.UnoCardColors {
+		for _, value := range cards.UnoCardValues {
+			card, err := cards.NewUnoCard(color.String(), value)
+			if err != nil {
+				return nil, errors.New("uno playing cards creation failure on deck generation")
+			}
+			unoCards = append(unoCards, card)
+		}
+	}
+	for _, value := range cards.UnoWildCardValues {
+		card, err := cards.NewUnoWildCard(value)
+		if err != nil {
+			return nil, errors.New("uno playing cards creation failure on deck generation")
+		}
+		unoCards = append(unoCards, card)
+	}
+
+	var playingCards []cards.PlayingCard
+	for _, card := range unoCards {
+		playingCards = append(playingCards, copyPlayingCards([]cards.PlayingCard{card.PlayingCard}, card.Copies())...)
+	}
+	if refinedRequestedCardCodes := refineRequestedCardCodes(requestedCardCodes); len(refinedRequestedCardCodes) > 0 {
+		return selectRequestedCopies(playingCards, refinedRequestedCardCodes)
+	}
+	return playingCards, nil
+}
@@ -0,0 +1,73 @@
+package decks
+
+import (
+	"croupier.io/cards"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewUnoDeck(t *testing.T) {
+	actualDeck, err := NewUnoDeck([]string{})
+	assert.Nil(t, err, "expected no error when generating the deck")
+	assert.Equal(t, 108, actualDeck.Remaining)
+	assert.False(t, actualDeck.Shuffled)
+
+	type cardIdentity struct {
+		code      string
+		copyIndex int
+	}
+	identities := make(map[cardIdentity]bool)
+	codeOccurrences := make(map[string]int)
+	for _, card := range actualDeck.Cards {
+		identity := cardIdentity{code: card.Code, copyIndex: card.CopyIndex}
+		assert.False(t, identities[identity], "expected an identifiable card; got '%s' #%d twice", card.Code, card.CopyIndex)
+		identities[identity] = true
+		codeOccurrences[card.Code] += 1
+	}
+	assert.Equal(t, 54, len(codeOccurrences), "expected the copies of a card to share its code")
+	assert.Equal(t, 1, codeOccurrences["0R"])
+	assert.Equal(t, 2, codeOccurrences["9B"])
+	assert.Equal(t, 2, codeOccurrences["D2Y"])
+	assert.Equal(t, 4, codeOccurrences["W"])
+	assert.Equal(t, 4, codeOccurrences["W4"])
+
+	assert.Equal(t, "0R", actualDeck.Cards[0].Code)
+	assert.Equal(t, cards.PlayingCard{Suit: "RED", Value: "1", Code: "1R", CopyIndex: 1}, actualDeck.Cards[1])
+	assert.Equal(t, cards.PlayingCard{Suit: "RED", Value: "1", Code: "1R", CopyIndex: 2}, actualDeck.Cards[2])
+	assert.Equal(t, cards.PlayingCard{Suit: cards.UnoWildSuit, Value: cards.UnoWildDrawFourValue, Code: "W4", CopyIndex: 4}, actualDeck.Cards[107])
+}
+
+func TestGenerateUnoDeckPlayingCards(t *testing.T) {
+	playingCards, err := generateUnoDeckPlayingCards([]string{"0G", "SB", "W"})
+	assert.Nil(t, err, "expected no error")
+	assert.Len(t, playingCards, 7, "expected every copy of the requested cards")
+	assert.Equal(t, "GREEN", playingCards[0].Suit)
+	assert.Equal(t, cards.UnoSkipValue, playingCards[1].Value)
+	assert.Equal(t, cards.UnoWildValue, playingCards[6].Value)
+
+	playingCards, err = generateUnoDeckPlayingCards([]string{"AS"})
+	assert.Nil(t, playingCards, "expected no playing cards")
+	assert.NotNil(t, err, "expected an error when generating the deck")
+}
+
+func TestCreateUnoDeck(t *testing.T) {
+	playingDeck, err := CreateDeck(CreationRequest{PlayingType: cards.Uno, Count: 2}, nil)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, cards.Uno, playingDeck.Type)
+	assert.Equal(t, 216, playingDeck.Remaining)
+
+	type cardIdentity struct {
+		code      string
+		deckIndex int
+		copyIndex int
+	}
+	identities := make(map[cardIdentity]bool)
+	for _, card := range playingDeck.Cards {
+		identity := cardIdentity{code: card.Code, deckIndex: card.DeckIndex, copyIndex: card.CopyIndex}
+		assert.False(t, identities[identity], "expected an identifiable card; got '%s' #%d.%d twice", card.Code, card.DeckIndex, card.CopyIndex)
+		identities[identity] = true
+	}
+
+	_, err = CreateDeck(CreationRequest{PlayingType: cards.Uno, Variant: "flip"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidCreationRequest)
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// deckFileExtension is the extension of the files in which a FileDeckRepository stores the decks.
+const deckFileExtension = ".json"
+
+// FileDeckRepository is a DeckRepository persisting each deck as a JSON file in a directory.
+// The decks stored in a FileDeckRepository survive the restarts of the API.
+type FileDeckRepository struct {
+	directory string
+	mutex     sync.Mutex
+	deckLocks map[string]*deckLock
+}
+
+// deckLock is the representation of the lock serializing the operations applied to a deck.
+// references counts the operations holding or waiting for the lock, so that the lock can be
+// released once unused.
+type deckLock struct {
+	mutex      sync.Mutex
+	references int
+}
+
+var _ DeckRepository = &FileDeckRepository{}
+
+// NewFileDeckRepository creates and returns a FileDeckRepository storing the decks in directory.
+// directory is created if it does not exist.
+// A successful NewFileDeckRepository returns err == nil.
+func NewFileDeckRepository(directory string) (*FileDeckRepository, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create the storage directory '%s': %w", directory, err)
+	}
+	return &FileDeckRepository{directory: directory, deckLocks: make(map[string]*deckLock)}, nil
+}
+
+// Create persists a new PlayableDeck.
+// Create fails with ErrDeckAlreadyExists if a PlayableDeck is already associated with the deck ID.
+func (repository *FileDeckRepository) Create(deck *decks.PlayableDeck) error {
+	id := deck.ID.String()
+	unlock := repository.lockDeck(id)
+	defer unlock()
+
+	if _, err := os.Stat(repository.deckPath(id)); err == nil {
+		return ErrDeckAlreadyExists
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to check the existence of deck '%s': %w", id, err)
+	}
+	return repository.writeDeck(deck)
+}
+
+// Get reads the PlayableDeck associated with id.
+// Get fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *FileDeckRepository) Get(id string) (*decks.PlayableDeck, error) {
+	if !isDeckID(id) {
+		return nil, ErrDeckNotFound
+	}
+	unlock := repository.lockDeck(id)
+	defer unlock()
+
+	return repository.readDeck(id)
+}
+
+// Update applies update to the PlayableDeck associated with id and persists the outcome if
+// update succeeds.
+// The updates of a same PlayableDeck are serialized.
+// Update fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *FileDeckRepository) Update(id string, update DeckUpdate) error {
+	if !isDeckID(id) {
+		return ErrDeckNotFound
+	}
+	unlock := repository.lockDeck(id)
+	defer unlock()
+
+	deck, err := repository.readDeck(id)
+	if err != nil {
+		return err
+	}
+	if err := update(deck); err != nil {
+		return err
+	}
+	return repository.writeDeck(deck)
+}
+
+// Delete removes the file of the PlayableDeck associated with id.
+// Delete fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *FileDeckRepository) Delete(id string) error {
+	if !isDeckID(id) {
+		return ErrDeckNotFound
+	}
+	unlock := repository.lockDeck(id)
+	defer unlock()
+
+	err := os.Remove(repository.deckPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrDeckNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("unable to remove deck '%s': %w", id, err)
+	}
+	return nil
+}
+
+// List reads all the persisted decks.
+func (repository *FileDeckRepository) List() ([]*decks.PlayableDeck, error) {
+	entries, err := os.ReadDir(repository.directory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the storage directory '%s': %w", repository.directory, err)
+	}
+	storedDecks := make([]*decks.PlayableDeck, 0, len(entries))
+	for _, entry := range entries {
+		id := strings.TrimSuffix(entry.Name(), deckFileExtension)
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), deckFileExtension) || !isDeckID(id) {
+			continue
+		}
+		deck, err := repository.Get(id)
+		if errors.Is(err, ErrDeckNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		storedDecks = append(storedDecks, deck)
+	}
+	return storedDecks, nil
+}
+
+// readDeck reads the file of the PlayableDeck associated with id.
+// readDeck fails with ErrDeckNotFound if the file does not exist.
+func (repository *FileDeckRepository) readDeck(id string) (*decks.PlayableDeck, error) {
+	content, err := os.ReadFile(repository.deckPath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrDeckNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read deck '%s': %w", id, err)
+	}
+	var deck decks.PlayableDeck
+	if err := json.Unmarshal(content, &deck); err != nil {
+		return nil, fmt.Errorf("unable to decode deck '%s': %w", id, err)
+	}
+	return &deck, nil
+}
+
+// writeDeck atomically writes deck in its file, so that a failure never leaves a partially
+// written deck behind.
+func (repository *FileDeckRepository) writeDeck(deck *decks.PlayableDeck) error {
+	id := deck.ID.String()
+	content, err := json.Marshal(deck)
+	if err != nil {
+		return fmt.Errorf("unable to encode deck '%s': %w", id, err)
+	}
+	if err := writeFileAtomically(repository.directory, id, content); err != nil {
+		return fmt.Errorf("unable to write deck '%s': %w", id, err)
+	}
+	return nil
+}
+
+// writeFileAtomically writes content in the file named id+deckFileExtension in directory, through
+// a temporary file renamed once written, so that a failure never leaves a partially written file
+// behind.
+func writeFileAtomically(directory string, id string, content []byte) error {
+	file, err := os.CreateTemp(directory, id+"-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filepath.Join(directory, id+deckFileExtension))
+}
+
+// deckPath returns the path of the file of the PlayableDeck associated with id.
+func (repository *FileDeckRepository) deckPath(id string) string {
+	return filepath.Join(repository.directory, id+deckFileExtension)
+}
+
+// lockDeck acquires the lock of the PlayableDeck associated with id and returns the function
+// releasing it.
+func (repository *FileDeckRepository) lockDeck(id string) (unlock func()) {
+	repository.mutex.Lock()
+	lock, isPresent := repository.deckLocks[id]
+	if !isPresent {
+		lock = &deckLock{}
+		repository.deckLocks[id] = lock
+	}
+	lock.references += 1
+	repository.mutex.Unlock()
+
+	lock.mutex.Lock()
+	return func() {
+		lock.mutex.Unlock()
+
+		repository.mutex.Lock()
+		lock.references -= 1
+		if lock.references == 0 {
+			delete(repository.deckLocks, id)
+		}
+		repository.mutex.Unlock()
+	}
+}
+
+// isDeckID returns true if id is a valid PlayableDeck ID.
+// isDeckID prevents arbitrary identifiers from being used as file paths.
+func isDeckID(id string) bool {
+	parsedID, err := uuid.Parse(id)
+	return err == nil && parsedID.String() == id
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"croupier.io/cards"
+	"croupier.io/decks"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestNewFileDeckRepositoryCreatesDirectory(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), "decks")
+
+	repository, err := NewFileDeckRepository(directory)
+	assert.Nil(t, err, "expected no error")
+	assert.NotNil(t, repository, "expected a repository")
+	assert.DirExists(t, directory)
+}
+
+func TestFileDeckRepositoryCreate(t *testing.T) {
+	repository, _ := NewFileDeckRepository(t.TempDir())
+	deck := newTestDeck(t)
+
+	assert.Nil(t, repository.Create(deck), "expected no error upon creation")
+	assert.ErrorIs(t, repository.Create(deck), ErrDeckAlreadyExists)
+}
+
+func TestFileDeckRepositoryPersistsDecks(t *testing.T) {
+	directory := t.TempDir()
+	repository, _ := NewFileDeckRepository(directory)
+	deck, _ := decks.CreateDeck(decks.CreationRequest{PlayingType: cards.French, Shuffled: true}, nil)
+	deck.DrawCard(5)
+	_ = repository.Create(deck)
+
+	reopenedRepository, _ := NewFileDeckRepository(directory)
+	storedDeck, err := reopenedRepository.Get(deck.ID.String())
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, deck, storedDeck, "expected the card order, shuffled state and remaining count to be persisted")
+}
+
+func TestFileDeckRepositoryGetUnknownDeck(t *testing.T) {
+	repository, _ := NewFileDeckRepository(t.TempDir())
+
+	for _, id := range []string{newTestDeck(t).ID.String(), "unknown_id", "../decks"} {
+		storedDeck, err := repository.Get(id)
+		assert.Nil(t, storedDeck, "expected no deck")
+		assert.ErrorIs(t, err, ErrDeckNotFound)
+	}
+}
+
+func TestFileDeckRepositoryUpdate(t *testing.T) {
+	repository, _ := NewFileDeckRepository(t.TempDir())
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	assert.ErrorIs(t, repository.Update(id, func(*decks.PlayableDeck) error { return nil }), ErrDeckNotFound)
+
+	_ = repository.Create(deck)
+	err := repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+		storedDeck.DrawCard(1)
+		return nil
+	})
+	assert.Nil(t, err, "expected no error upon update")
+
+	storedDeck, _ := repository.Get(id)
+	assert.Equal(t, deck.Remaining-1, storedDeck.Remaining, "expected the updated deck")
+	assert.Equal(t, deck.Cards[1:], storedDeck.Cards, "expected the updated deck")
+
+	updateErr := errors.New("update failure")
+	err = repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+		storedDeck.DrawCard(1)
+		return updateErr
+	})
+	assert.ErrorIs(t, err, updateErr)
+	unchangedDeck, _ := repository.Get(id)
+	assert.Equal(t, storedDeck, unchangedDeck, "expected the deck to be untouched")
+}
+
+func TestFileDeckRepositoryDelete(t *testing.T) {
+	directory := t.TempDir()
+	repository, _ := NewFileDeckRepository(directory)
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	assert.ErrorIs(t, repository.Delete(id), ErrDeckNotFound)
+
+	_ = repository.Create(deck)
+	assert.Nil(t, repository.Delete(id), "expected no error upon deletion")
+	assert.NoFileExists(t, filepath.Join(directory, id+deckFileExtension))
+
+	_, err := repository.Get(id)
+	assert.ErrorIs(t, err, ErrDeckNotFound)
+}
+
+func TestFileDeckRepositoryList(t *testing.T) {
+	directory := t.TempDir()
+	repository, _ := NewFileDeckRepository(directory)
+	_ = os.WriteFile(filepath.Join(directory, "notes.txt"), []byte("not a deck"), 0o644)
+
+	firstDeck := newTestDeck(t)
+	secondDeck := newTestDeck(t)
+	_ = repository.Create(firstDeck)
+	_ = repository.Create(secondDeck)
+
+	storedDecks, err := repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.ElementsMatch(t, []*decks.PlayableDeck{firstDeck, secondDeck}, storedDecks)
+}
+
+func TestFileDeckRepositoryConcurrentUpdates(t *testing.T) {
+	repository, _ := NewFileDeckRepository(t.TempDir())
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	_ = repository.Create(deck)
+
+	drawnCards := make(chan cards.PlayingCard, len(deck.Cards))
+	var waitGroup sync.WaitGroup
+	for i := 0; i < len(deck.Cards); i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_ = repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+				for _, card := range storedDeck.DrawCard(1) {
+					drawnCards <- card
+				}
+				return nil
+			})
+		}()
+	}
+	waitGroup.Wait()
+	close(drawnCards)
+
+	var actualCards []cards.PlayingCard
+	for card := range drawnCards {
+		actualCards = append(actualCards, card)
+	}
+	assert.ElementsMatch(t, deck.Cards, actualCards, "expected every card to be drawn exactly once")
+	assert.Empty(t, repository.deckLocks, "expected the deck locks to be released")
+}
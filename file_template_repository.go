@@ -0,0 +1,140 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// templateDirectoryName is the name of the subdirectory of the storage directory in which a
+// FileTemplateRepository stores the templates.
+const templateDirectoryName = "templates"
+
+// FileTemplateRepository is a TemplateRepository persisting each template as a JSON file in a
+// directory.
+// The templates stored in a FileTemplateRepository survive the restarts of the API.
+type FileTemplateRepository struct {
+	directory string
+	mutex     sync.RWMutex
+}
+
+var _ TemplateRepository = &FileTemplateRepository{}
+
+// NewFileTemplateRepository creates and returns a FileTemplateRepository storing the templates in
+// directory.
+// directory is created if it does not exist.
+// A successful NewFileTemplateRepository returns err == nil.
+func NewFileTemplateRepository(directory string) (*FileTemplateRepository, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create the template directory '%s': %w", directory, err)
+	}
+	return &FileTemplateRepository{directory: directory}, nil
+}
+
+// Create persists a new Template.
+// Create fails with ErrTemplateAlreadyExists if a Template is already associated with the
+// template ID.
+func (repository *FileTemplateRepository) Create(template *decks.Template) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	id := template.ID.String()
+	if _, err := os.Stat(repository.templatePath(id)); err == nil {
+		return ErrTemplateAlreadyExists
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to check the existence of template '%s': %w", id, err)
+	}
+	content, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("unable to encode template '%s': %w", id, err)
+	}
+	if err := writeFileAtomically(repository.directory, id, content); err != nil {
+		return fmt.Errorf("unable to write template '%s': %w", id, err)
+	}
+	return nil
+}
+
+// Get reads the Template associated with id.
+// Get fails with ErrTemplateNotFound if no Template is associated with id.
+func (repository *FileTemplateRepository) Get(id string) (*decks.Template, error) {
+	if !isDeckID(id) {
+		return nil, ErrTemplateNotFound
+	}
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	return repository.readTemplate(id)
+}
+
+// Delete removes the file of the Template associated with id.
+// Delete fails with ErrTemplateNotFound if no Template is associated with id.
+func (repository *FileTemplateRepository) Delete(id string) error {
+	if !isDeckID(id) {
+		return ErrTemplateNotFound
+	}
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	err := os.Remove(repository.templatePath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrTemplateNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("unable to remove template '%s': %w", id, err)
+	}
+	return nil
+}
+
+// List reads all the persisted templates.
+func (repository *FileTemplateRepository) List() ([]*decks.Template, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	entries, err := os.ReadDir(repository.directory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the template directory '%s': %w", repository.directory, err)
+	}
+	storedTemplates := make([]*decks.Template, 0, len(entries))
+	for _, entry := range entries {
+		id := strings.TrimSuffix(entry.Name(), deckFileExtension)
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), deckFileExtension) || !isDeckID(id) {
+			continue
+		}
+		template, err := repository.readTemplate(id)
+		if errors.Is(err, ErrTemplateNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		storedTemplates = append(storedTemplates, template)
+	}
+	return storedTemplates, nil
+}
+
+// readTemplate reads the file of the Template associated with id.
+// readTemplate fails with ErrTemplateNotFound if the file does not exist.
+func (repository *FileTemplateRepository) readTemplate(id string) (*decks.Template, error) {
+	content, err := os.ReadFile(repository.templatePath(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read template '%s': %w", id, err)
+	}
+	var template decks.Template
+	if err := json.Unmarshal(content, &template); err != nil {
+		return nil, fmt.Errorf("unable to decode template '%s': %w", id, err)
+	}
+	return &template, nil
+}
+
+// templatePath returns the path of the file of the Template associated with id.
+func (repository *FileTemplateRepository) templatePath(id string) string {
+	return filepath.Join(repository.directory, id+deckFileExtension)
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewFileTemplateRepositoryCreatesDirectory(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), templateDirectoryName)
+
+	repository, err := NewFileTemplateRepository(directory)
+	assert.Nil(t, err, "expected no error")
+	assert.NotNil(t, repository, "expected a repository")
+	assert.DirExists(t, directory)
+}
+
+func TestFileTemplateRepositoryCreate(t *testing.T) {
+	repository, _ := NewFileTemplateRepository(t.TempDir())
+	template := newTestTemplate(t)
+
+	assert.Nil(t, repository.Create(template), "expected no error upon creation")
+	assert.ErrorIs(t, repository.Create(template), ErrTemplateAlreadyExists)
+}
+
+func TestFileTemplateRepositoryPersistsTemplates(t *testing.T) {
+	directory := t.TempDir()
+	repository, _ := NewFileTemplateRepository(directory)
+	template := newTestTemplate(t)
+	_ = repository.Create(template)
+
+	reopenedRepository, _ := NewFileTemplateRepository(directory)
+	storedTemplate, err := reopenedRepository.Get(template.ID.String())
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, template, storedTemplate, "expected the template to be persisted")
+}
+
+func TestFileTemplateRepositoryGetUnknownTemplate(t *testing.T) {
+	repository, _ := NewFileTemplateRepository(t.TempDir())
+
+	for _, id := range []string{newTestTemplate(t).ID.String(), "unknown_id", "../templates"} {
+		storedTemplate, err := repository.Get(id)
+		assert.Nil(t, storedTemplate, "expected no template")
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	}
+}
+
+func TestFileTemplateRepositoryDelete(t *testing.T) {
+	repository, _ := NewFileTemplateRepository(t.TempDir())
+	template := newTestTemplate(t)
+	id := template.ID.String()
+	assert.ErrorIs(t, repository.Delete(id), ErrTemplateNotFound)
+
+	_ = repository.Create(template)
+	assert.Nil(t, repository.Delete(id), "expected no error upon deletion")
+	_, err := repository.Get(id)
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestFileTemplateRepositoryList(t *testing.T) {
+	directory := t.TempDir()
+	repository, _ := NewFileTemplateRepository(directory)
+	firstTemplate, secondTemplate := newTestTemplate(t), newTestTemplate(t)
+	_ = repository.Create(firstTemplate)
+	_ = repository.Create(secondTemplate)
+	_ = os.WriteFile(filepath.Join(directory, "notes.txt"), []byte("not a template"), 0o644)
+
+	templates, err := repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.ElementsMatch(t, []*decks.Template{firstTemplate, secondTemplate}, templates)
+}
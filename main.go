@@ -1,12 +1,27 @@
 package main
 
 import (
+	"context"
 	"log"
 )
 
 func main() {
-	router := NewRouter()
-	err := router.Run()
+	config, err := LoadConfig()
+	if err != nil {
+		log.Fatalf("API configuration failure: %s", err)
+	}
+	repository, err := NewDeckRepository(config)
+	if err != nil {
+		log.Fatalf("API storage failure: %s", err)
+	}
+	templateRepository, err := NewTemplateRepository(config)
+	if err != nil {
+		log.Fatalf("API storage failure: %s", err)
+	}
+	go NewDeckSweeper(repository, config.ExpiredDeckRetention).Run(context.Background(), config.SweepInterval)
+
+	router := NewRouter(config, repository, templateRepository)
+	err = router.Run()
 	if err != nil {
 		log.Fatalf("API start failure: %s", err)
 	}
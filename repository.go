@@ -0,0 +1,169 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"errors"
+	"sync"
+)
+
+// ErrDeckNotFound is returned by a DeckRepository when no PlayableDeck is associated with an ID.
+var ErrDeckNotFound = errors.New("deck not found")
+
+// ErrDeckAlreadyExists is returned by a DeckRepository when a PlayableDeck is already associated with an ID.
+var ErrDeckAlreadyExists = errors.New("deck already exists")
+
+// DeckUpdate is the function applied by a DeckRepository to modify a stored PlayableDeck.
+// Returning an error from a DeckUpdate aborts the update and leaves the stored PlayableDeck untouched.
+type DeckUpdate func(deck *decks.PlayableDeck) error
+
+// DeckRepository is the interface that wraps the methods used to store and retrieve decks.
+// Any implementation must be safe for concurrent use.
+//
+// Create stores a new PlayableDeck.
+// Create must fail with ErrDeckAlreadyExists if a PlayableDeck is already associated with the deck ID.
+//
+// Get retrieves a copy of the PlayableDeck associated with an ID.
+// Get must fail with ErrDeckNotFound if no PlayableDeck is associated with the ID.
+//
+// Update applies a DeckUpdate to the PlayableDeck associated with an ID and stores the outcome.
+// The updates of a same PlayableDeck must be serialized, so that concurrent updates never
+// observe nor overwrite each other's intermediate state.
+// Update must fail with ErrDeckNotFound if no PlayableDeck is associated with the ID, and must
+// return the error of the DeckUpdate, if any.
+//
+// Delete removes the PlayableDeck associated with an ID.
+// Delete must fail with ErrDeckNotFound if no PlayableDeck is associated with the ID.
+//
+// List retrieves a copy of all the stored decks.
+type DeckRepository interface {
+	Create(deck *decks.PlayableDeck) error
+	Get(id string) (*decks.PlayableDeck, error)
+	Update(id string, update DeckUpdate) error
+	Delete(id string) error
+	List() ([]*decks.PlayableDeck, error)
+}
+
+// MemoryDeckRepository is a DeckRepository keeping the decks in memory.
+// The decks stored in a MemoryDeckRepository are lost once the API stops.
+type MemoryDeckRepository struct {
+	mutex   sync.RWMutex
+	entries map[string]*memoryDeckEntry
+}
+
+// memoryDeckEntry is the representation of a PlayableDeck stored in a MemoryDeckRepository.
+// The mutex of a memoryDeckEntry serializes the operations applied to its deck.
+type memoryDeckEntry struct {
+	mutex   sync.Mutex
+	deck    *decks.PlayableDeck
+	deleted bool
+}
+
+var _ DeckRepository = &MemoryDeckRepository{}
+
+// NewMemoryDeckRepository creates and returns an empty MemoryDeckRepository.
+func NewMemoryDeckRepository() *MemoryDeckRepository {
+	return &MemoryDeckRepository{entries: make(map[string]*memoryDeckEntry)}
+}
+
+// Create stores a copy of a new PlayableDeck.
+// Create fails with ErrDeckAlreadyExists if a PlayableDeck is already associated with the deck ID.
+func (repository *MemoryDeckRepository) Create(deck *decks.PlayableDeck) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	id := deck.ID.String()
+	if _, isPresent := repository.entries[id]; isPresent {
+		return ErrDeckAlreadyExists
+	}
+	repository.entries[id] = &memoryDeckEntry{deck: deck.Clone()}
+	return nil
+}
+
+// Get retrieves a copy of the PlayableDeck associated with id.
+// Get fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *MemoryDeckRepository) Get(id string) (*decks.PlayableDeck, error) {
+	entry, err := repository.lockEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	defer entry.mutex.Unlock()
+
+	return entry.deck.Clone(), nil
+}
+
+// Update applies update to a copy of the PlayableDeck associated with id and stores the outcome
+// if update succeeds.
+// The updates of a same PlayableDeck are serialized.
+// Update fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *MemoryDeckRepository) Update(id string, update DeckUpdate) error {
+	entry, err := repository.lockEntry(id)
+	if err != nil {
+		return err
+	}
+	defer entry.mutex.Unlock()
+
+	updatedDeck := entry.deck.Clone()
+	if err := update(updatedDeck); err != nil {
+		return err
+	}
+	entry.deck = updatedDeck
+	return nil
+}
+
+// Delete removes the PlayableDeck associated with id.
+// Delete waits for the pending updates of the PlayableDeck to complete.
+// Delete fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *MemoryDeckRepository) Delete(id string) error {
+	repository.mutex.Lock()
+	entry, isPresent := repository.entries[id]
+	if !isPresent {
+		repository.mutex.Unlock()
+		return ErrDeckNotFound
+	}
+	delete(repository.entries, id)
+	repository.mutex.Unlock()
+
+	entry.mutex.Lock()
+	entry.deleted = true
+	entry.mutex.Unlock()
+	return nil
+}
+
+// List retrieves a copy of all the stored decks.
+func (repository *MemoryDeckRepository) List() ([]*decks.PlayableDeck, error) {
+	repository.mutex.RLock()
+	entries := make([]*memoryDeckEntry, 0, len(repository.entries))
+	for _, entry := range repository.entries {
+		entries = append(entries, entry)
+	}
+	repository.mutex.RUnlock()
+
+	storedDecks := make([]*decks.PlayableDeck, 0, len(entries))
+	for _, entry := range entries {
+		entry.mutex.Lock()
+		if !entry.deleted {
+			storedDecks = append(storedDecks, entry.deck.Clone())
+		}
+		entry.mutex.Unlock()
+	}
+	return storedDecks, nil
+}
+
+// lockEntry finds and locks the memoryDeckEntry associated with id.
+// The caller is responsible for unlocking the returned memoryDeckEntry.
+// lockEntry fails with ErrDeckNotFound if no PlayableDeck is associated with id.
+func (repository *MemoryDeckRepository) lockEntry(id string) (*memoryDeckEntry, error) {
+	repository.mutex.RLock()
+	entry, isPresent := repository.entries[id]
+	repository.mutex.RUnlock()
+	if !isPresent {
+		return nil, ErrDeckNotFound
+	}
+
+	entry.mutex.Lock()
+	if entry.deleted {
+		entry.mutex.Unlock()
+		return nil, ErrDeckNotFound
+	}
+	return entry, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"croupier.io/cards"
+	"croupier.io/decks"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestMemoryDeckRepositoryCreate(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+
+	assert.Nil(t, repository.Create(deck), "expected no error upon creation")
+	assert.ErrorIs(t, repository.Create(deck), ErrDeckAlreadyExists)
+}
+
+func TestMemoryDeckRepositoryGet(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	_ = repository.Create(deck)
+
+	storedDeck, err := repository.Get(deck.ID.String())
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, deck, storedDeck, "expected identical decks")
+
+	storedDeck, err = repository.Get("unknown_id")
+	assert.Nil(t, storedDeck, "expected no deck")
+	assert.ErrorIs(t, err, ErrDeckNotFound)
+}
+
+func TestMemoryDeckRepositoryUpdate(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	assert.ErrorIs(t, repository.Update(id, func(*decks.PlayableDeck) error { return nil }), ErrDeckNotFound)
+
+	_ = repository.Create(deck)
+	err := repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+		storedDeck.DrawCard(1)
+		return nil
+	})
+	assert.Nil(t, err, "expected no error upon update")
+
+	storedDeck, _ := repository.Get(id)
+	assert.Equal(t, deck.Remaining-1, storedDeck.Remaining, "expected the updated deck")
+	assert.Equal(t, deck.Cards[1:], storedDeck.Cards, "expected the updated deck")
+}
+
+func TestMemoryDeckRepositoryFailedUpdate(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	_ = repository.Create(deck)
+
+	updateErr := errors.New("update failure")
+	err := repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+		storedDeck.DrawCard(1)
+		return updateErr
+	})
+	assert.ErrorIs(t, err, updateErr)
+
+	storedDeck, _ := repository.Get(id)
+	assert.Equal(t, deck, storedDeck, "expected the deck to be untouched")
+}
+
+func TestMemoryDeckRepositoryGetReturnsCopy(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	_ = repository.Create(deck)
+
+	storedDeck, _ := repository.Get(deck.ID.String())
+	storedDeck.DrawCard(1)
+
+	storedDeck, _ = repository.Get(deck.ID.String())
+	assert.Equal(t, deck, storedDeck, "expected the stored deck to be untouched")
+}
+
+func TestMemoryDeckRepositoryConcurrentUpdates(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	id := deck.ID.String()
+	_ = repository.Create(deck)
+
+	drawnCards := make(chan cards.PlayingCard, len(deck.Cards))
+	var waitGroup sync.WaitGroup
+	for i := 0; i < len(deck.Cards); i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_ = repository.Update(id, func(storedDeck *decks.PlayableDeck) error {
+				for _, card := range storedDeck.DrawCard(1) {
+					drawnCards <- card
+				}
+				return nil
+			})
+		}()
+	}
+	waitGroup.Wait()
+	close(drawnCards)
+
+	var actualCards []cards.PlayingCard
+	for card := range drawnCards {
+		actualCards = append(actualCards, card)
+	}
+	assert.ElementsMatch(t, deck.Cards, actualCards, "expected every card to be drawn exactly once")
+
+	storedDeck, _ := repository.Get(id)
+	assert.Zero(t, storedDeck.Remaining, "expected no remaining cards")
+	assert.Empty(t, storedDeck.Cards, "expected no cards left in the deck")
+}
+
+func TestMemoryDeckRepositoryDelete(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	deck := newTestDeck(t)
+	assert.ErrorIs(t, repository.Delete(deck.ID.String()), ErrDeckNotFound)
+
+	_ = repository.Create(deck)
+	assert.Nil(t, repository.Delete(deck.ID.String()), "expected no error upon deletion")
+
+	_, err := repository.Get(deck.ID.String())
+	assert.ErrorIs(t, err, ErrDeckNotFound)
+}
+
+func TestMemoryDeckRepositoryList(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	storedDecks, err := repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.Empty(t, storedDecks, "expected no decks")
+
+	firstDeck := newTestDeck(t)
+	secondDeck := newTestDeck(t)
+	_ = repository.Create(firstDeck)
+	_ = repository.Create(secondDeck)
+
+	storedDecks, err = repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.ElementsMatch(t, []*decks.PlayableDeck{firstDeck, secondDeck}, storedDecks)
+}
+
+func newTestDeck(t *testing.T) *decks.PlayableDeck {
+	deck, err := decks.CreateDeck(decks.CreationRequest{PlayingType: cards.French}, nil)
+	if err != nil {
+		t.Fatalf("unable to create the test deck: %s", err)
+	}
+	return deck
+}
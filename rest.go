@@ -5,20 +5,52 @@ import (
 )
 
 // NewRouter adds all the routes and route handlers necessary for the API and returns a router.
-func NewRouter() *gin.Engine {
+// The decks handled by the API are stored in repository, and the deck templates in
+// templateRepository.
+func NewRouter(config Config, repository DeckRepository, templateRepository TemplateRepository) *gin.Engine {
 	router := gin.Default()
 
-	AddDeckApi(router)
+	AddDeckApi(router, config, repository, templateRepository)
+	AddTemplateApi(router, templateRepository)
 
 	return router
 }
 
 // AddDeckApi attaches the routes and route handlers associated with decks.
-func AddDeckApi(router *gin.Engine) {
+// The route handlers store and retrieve the decks through repository, and create custom decks out
+// of the templates of templateRepository.
+func AddDeckApi(router *gin.Engine, config Config, repository DeckRepository, templateRepository TemplateRepository) {
+	service := newDeckService(config, repository, templateRepository)
 	deckApi := router.Group("/decks")
 	{
-		deckApi.POST("", createDeck)
-		deckApi.GET("/:id", openDeck)
-		deckApi.POST("/:id/cards/draw", drawCard)
+		deckApi.POST("", service.createDeck)
+		deckApi.GET("/:id", service.openDeck)
+		deckApi.DELETE("/:id", service.deleteDeck)
+		deckApi.POST("/:id/close", service.closeDeck)
+		deckApi.POST("/:id/shuffle", service.shuffleDeck)
+		deckApi.POST("/:id/cut", service.cutDeck)
+		deckApi.GET("/:id/reveal", service.revealDeck)
+		deckApi.POST("/:id/cards/draw", service.drawCard)
+		deckApi.GET("/:id/cards/peek", service.peekCard)
+		deckApi.POST("/:id/cards/discard", service.discardCard)
+		deckApi.POST("/:id/cards/return", service.returnCard)
+		deckApi.POST("/:id/discard/reshuffle", service.reshuffleDiscarded)
+		deckApi.GET("/:id/piles/:pile", service.openPile)
+		deckApi.POST("/:id/piles/:pile/add", service.drawCardToPile)
+		deckApi.POST("/:id/piles/:pile/draw", service.drawCardFromPile)
+		deckApi.POST("/:id/piles/:pile/move", service.moveCard)
+	}
+}
+
+// AddTemplateApi attaches the routes and route handlers associated with deck templates.
+// The route handlers store and retrieve the templates through repository.
+func AddTemplateApi(router *gin.Engine, repository TemplateRepository) {
+	service := newTemplateService(repository)
+	templateApi := router.Group("/templates")
+	{
+		templateApi.POST("", service.createTemplate)
+		templateApi.GET("", service.listTemplates)
+		templateApi.GET("/:id", service.openTemplate)
+		templateApi.DELETE("/:id", service.deleteTemplate)
 	}
 }
@@ -1,78 +1,784 @@
 package main
 
 import (
+	"croupier.io/cards"
 	"croupier.io/decks"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
-var playingDecks []*decks.PlayableDeck
+// errDeckExpired is returned when an operation is applied to an expired PlayableDeck.
+var errDeckExpired = errors.New("deck expired")
+
+// errSeedUnsupported is returned when a seed is provided to shuffle a PlayableDeck which does not
+// support seeded shuffles.
+var errSeedUnsupported = errors.New("a secure shuffle cannot be seeded")
+
+// errDeckCommitted is returned when a provably fair PlayableDeck would be reordered, which would
+// break the commitment made upon its shuffle.
+var errDeckCommitted = errors.New("a provably fair deck cannot be reordered")
+
+// deckService is the representation of the route handlers associated with decks.
+type deckService struct {
+	repository         DeckRepository
+	templateRepository TemplateRepository
+	deckTTL            time.Duration
+}
+
+// newDeckService creates and returns a deckService configured by config, storing the decks in
+// repository and reading the deck templates from templateRepository.
+func newDeckService(config Config, repository DeckRepository, templateRepository TemplateRepository) *deckService {
+	return &deckService{repository: repository, templateRepository: templateRepository, deckTTL: config.DeckTTL}
+}
 
 // createDeck creates and stores a PlayableDeck.
-func createDeck(context *gin.Context) {
+// The PlayableDeck is created out of a deck template if a template ID is provided.
+// The PlayableDeck expires after the configured TTL unless requested otherwise.
+func (service *deckService) createDeck(context *gin.Context) {
 	var request decks.CreationRequest
 	if err := context.BindJSON(&request); err != nil {
 		log.Printf("Failed to get the decks creation request: %s", err)
 		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to generate the deck"})
 		return
 	}
+	if request.TemplateID != "" {
+		request.PlayingType = cards.Custom
+	}
+	if err := request.Validate(); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	if request.TTL == 0 {
+		request.TTL = int(service.deckTTL.Seconds())
+	}
 	requestedCards := strings.Split(context.Query("cards"), ",")
 
-	playingDeck, err := decks.CreateDeck(request, requestedCards)
+	var playingDeck *decks.PlayableDeck
+	var err error
+	if request.TemplateID != "" {
+		template, templateErr := service.templateRepository.Get(request.TemplateID)
+		if errors.Is(templateErr, ErrTemplateNotFound) {
+			context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the template"})
+			return
+		}
+		if templateErr != nil {
+			log.Printf("Failed to retrieve the template: %s", templateErr)
+			context.JSON(http.StatusInternalServerError, gin.H{"message": "unable to generate the deck"})
+			return
+		}
+		playingDeck, err = decks.CreateDeckFromTemplate(request, template, requestedCards)
+	} else {
+		playingDeck, err = decks.CreateDeck(request, requestedCards)
+	}
+	if respondInvalidCardCodes(context, err) {
+		return
+	}
 	if err != nil {
 		log.Printf("Failed to create the decks: %s", err)
 		context.JSON(http.StatusInternalServerError, gin.H{"message": "unable to generate the deck"})
 		return
 	}
-	playingDecks = append(playingDecks, playingDeck)
+	playingDeck.Touch(time.Now())
+	if err := service.repository.Create(playingDeck); err != nil {
+		log.Printf("Failed to store the deck: %s", err)
+		context.JSON(http.StatusInternalServerError, gin.H{"message": "unable to generate the deck"})
+		return
+	}
+	playingDeck.Conceal()
 	context.JSON(
 		http.StatusCreated,
 		gin.H{
-			"deck_id":   playingDeck.ID,
-			"shuffled":  playingDeck.Shuffled,
-			"remaining": playingDeck.Remaining,
+			"deck_id":    playingDeck.ID,
+			"shuffled":   playingDeck.Shuffled,
+			"seed":       playingDeck.Seed,
+			"fairness":   playingDeck.Fairness,
+			"remaining":  playingDeck.Remaining,
+			"expires_at": playingDeck.ExpiresAt,
 		})
 }
 
 // openDeck finds a PlayableDeck associated with a provided ID, if any.
-func openDeck(context *gin.Context) {
-	id := context.Param("id")
-	playingDeck := findDeck(id)
-	if playingDeck == nil {
-		context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the deck"})
+func (service *deckService) openDeck(context *gin.Context) {
+	var playingDeck *decks.PlayableDeck
+	err := service.updateDeck(context.Param("id"), func(deck *decks.PlayableDeck) error {
+		playingDeck = deck.Clone()
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to retrieve the deck") {
 		return
 	}
+	playingDeck.Conceal()
 	context.JSON(http.StatusOK, playingDeck)
 }
 
+// closeDeck closes a PlayableDeck associated with a provided ID, if any, so that its secrets can
+// be revealed.
+func (service *deckService) closeDeck(context *gin.Context) {
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		playingDeck.Close()
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to close the deck") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"closed": true,
+	})
+}
+
+// revealDeck discloses the shuffle commitment of a PlayableDeck associated with a provided ID,
+// including its secrets, once the deck is exhausted or closed.
+func (service *deckService) revealDeck(context *gin.Context) {
+	var fairness *decks.Fairness
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		var err error
+		fairness, err = playingDeck.Reveal()
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to reveal the deck") {
+		return
+	}
+	context.JSON(http.StatusOK, fairness)
+}
+
+// deleteDeck removes a PlayableDeck associated with a provided ID, if any.
+// An expired PlayableDeck can be removed.
+func (service *deckService) deleteDeck(context *gin.Context) {
+	err := service.repository.Delete(context.Param("id"))
+	if !service.handleDeckError(context, err, "unable to delete the deck") {
+		return
+	}
+	context.Status(http.StatusNoContent)
+}
+
 // drawCard draws cards from a PlayableDeck associated with a provided ID, if applicable.
-func drawCard(context *gin.Context) {
-	id := context.Param("id")
+// Either the codes of the cards to draw are provided in the cards query parameter, or the number
+// of cards to draw is provided in the count query parameter, along with the position to draw
+// them from in the from query parameter.
+// A strict draw, requested by the strict query parameter or by the policy of the deck, draws exactly
+// the number of cards requested or none.
+func (service *deckService) drawCard(context *gin.Context) {
+	comparator, ok := parseOrdering(context)
+	if !ok {
+		return
+	}
+	var drawCards func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error)
+	if context.Query("cards") != "" {
+		cardCodes, ok := requireCardCodes(context)
+		if !ok {
+			return
+		}
+		drawCards = func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error) {
+			return playingDeck.DrawCardByCodes(cardCodes)
+		}
+	} else {
+		requestedDrawCardCount, err := strconv.Atoi(context.Query("count"))
+		if err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested number of cards to draw"})
+			return
+		}
+		position, err := decks.ParseDrawPosition(context.Query("from"))
+		if err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"message": "the requested position must be one of top, bottom or random"})
+			return
+		}
+		strict, ok := parseStrict(context)
+		if !ok {
+			return
+		}
+		drawCards = func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error) {
+			if strict || playingDeck.StrictDraw {
+				return playingDeck.DrawCardStrictly(requestedDrawCardCount, position)
+			}
+			return playingDeck.DrawCardFrom(requestedDrawCardCount, position), nil
+		}
+	}
+	var drawnCards []cards.PlayingCard
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if err := checkOrdering(comparator, playingDeck.Type); err != nil {
+			return err
+		}
+		var err error
+		drawnCards, err = drawCards(playingDeck)
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to draw cards from the deck") {
+		return
+	}
+	sortCards(drawnCards, comparator)
+	context.JSON(http.StatusOK, gin.H{
+		"cards": drawnCards,
+	})
+}
+
+// peekCard retrieves cards from the top of a PlayableDeck associated with a provided ID, if
+// applicable, without drawing them.
+// The retrieved cards are sorted according to the ordering provided in the sort query parameter,
+// if any.
+func (service *deckService) peekCard(context *gin.Context) {
+	requestedPeekCardCount, err := strconv.Atoi(context.Query("count"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested number of cards to peek at"})
+		return
+	}
+	comparator, ok := parseOrdering(context)
+	if !ok {
+		return
+	}
+	var peekedCards []cards.PlayingCard
+	err = service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if err := checkOrdering(comparator, playingDeck.Type); err != nil {
+			return err
+		}
+		peekedCards = playingDeck.Peek(requestedPeekCardCount)
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to peek at the deck") {
+		return
+	}
+	sortCards(peekedCards, comparator)
+	context.JSON(http.StatusOK, gin.H{
+		"cards": peekedCards,
+	})
+}
+
+// discardCard moves drawn cards to the discard pile of a PlayableDeck associated with a provided
+// ID, if applicable.
+func (service *deckService) discardCard(context *gin.Context) {
+	cardCodes, ok := requireCardCodes(context)
+	if !ok {
+		return
+	}
+	var discardedCards []cards.PlayingCard
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		var err error
+		discardedCards, err = playingDeck.Discard(cardCodes)
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to discard cards") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"cards": discardedCards,
+	})
+}
+
+// returnCard puts drawn or discarded cards back in a PlayableDeck associated with a provided ID,
+// if applicable.
+// The cards are put on top of the deck unless another position is requested.
+func (service *deckService) returnCard(context *gin.Context) {
+	cardCodes, ok := requireCardCodes(context)
+	if !ok {
+		return
+	}
+	position, err := decks.ParseReturnPosition(context.Query("position"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "the requested position must be one of top, bottom or random"})
+		return
+	}
+	var returnedCards []cards.PlayingCard
+	var remaining int
+	err = service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		var err error
+		returnedCards, err = playingDeck.Return(cardCodes, position)
+		remaining = playingDeck.Remaining
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to return cards to the deck") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"cards":     returnedCards,
+		"remaining": remaining,
+	})
+}
+
+// reshuffleDiscarded shuffles the discard pile back into a PlayableDeck associated with a provided
+// ID, if any.
+// The cards are shuffled with the seed provided in the seed query parameter, or a random one.
+// The shuffle sequence provided in the shuffle_sequence query parameter, if any, replaces the
+// shuffle sequence of the deck.
+// The discard pile of a provably fair deck cannot be reshuffled, as its order is committed.
+func (service *deckService) reshuffleDiscarded(context *gin.Context) {
+	seed, ok := parseSeed(context)
+	if !ok {
+		return
+	}
+	shuffleSequence, ok := parseShuffleSequence(context)
+	if !ok {
+		return
+	}
+	var shuffledDeck *decks.PlayableDeck
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if playingDeck.Fairness != nil {
+			return errDeckCommitted
+		}
+		if seed != nil && playingDeck.ShuffleMode == decks.SecureShuffle {
+			return errSeedUnsupported
+		}
+		if shuffleSequence != nil {
+			if err := playingDeck.SetShuffleSequence(*shuffleSequence); err != nil {
+				return err
+			}
+		}
+		if seed != nil {
+			playingDeck.ReshuffleDiscardedWithSeed(*seed)
+		} else {
+			playingDeck.ReshuffleDiscarded()
+		}
+		shuffledDeck = playingDeck.Clone()
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to reshuffle the discard pile") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"shuffled":         shuffledDeck.Shuffled,
+		"seed":             shuffledDeck.Seed,
+		"shuffle_sequence": shuffledDeck.ShuffleSequence,
+		"remaining":        shuffledDeck.Remaining,
+	})
+}
+
+// shuffleDeck shuffles a PlayableDeck associated with a provided ID, if any.
+// Every card drawn, discarded or added to a pile is gathered back in the deck before the shuffle,
+// unless the remaining query parameter is true.
+// The cards are shuffled with the seed provided in the seed query parameter, or a random one.
+// The shuffle sequence provided in the shuffle_sequence query parameter, if any, replaces the
+// shuffle sequence of the deck.
+// A provably fair deck cannot be shuffled, as its order is committed.
+func (service *deckService) shuffleDeck(context *gin.Context) {
+	remainingOnly := false
+	if rawRemaining := context.Query("remaining"); rawRemaining != "" {
+		var err error
+		remainingOnly, err = strconv.ParseBool(rawRemaining)
+		if err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"message": "the remaining parameter must be a boolean"})
+			return
+		}
+	}
+	seed, ok := parseSeed(context)
+	if !ok {
+		return
+	}
+	shuffleSequence, ok := parseShuffleSequence(context)
+	if !ok {
+		return
+	}
+	var shuffledDeck *decks.PlayableDeck
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if playingDeck.Fairness != nil {
+			return errDeckCommitted
+		}
+		if seed != nil && playingDeck.ShuffleMode == decks.SecureShuffle {
+			return errSeedUnsupported
+		}
+		if shuffleSequence != nil {
+			if err := playingDeck.SetShuffleSequence(*shuffleSequence); err != nil {
+				return err
+			}
+		}
+		if !remainingOnly {
+			playingDeck.Gather()
+		}
+		if seed != nil {
+			playingDeck.ShuffleWithSeed(*seed)
+		} else {
+			playingDeck.Shuffle()
+		}
+		shuffledDeck = playingDeck.Clone()
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to shuffle the deck") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"shuffled":         shuffledDeck.Shuffled,
+		"seed":             shuffledDeck.Seed,
+		"shuffle_sequence": shuffledDeck.ShuffleSequence,
+		"remaining":        shuffledDeck.Remaining,
+	})
+}
+
+// cutDeck cuts a PlayableDeck associated with a provided ID, if any, at the position provided in
+// the at query parameter.
+// A provably fair deck cannot be cut, as its order is committed.
+func (service *deckService) cutDeck(context *gin.Context) {
+	position, err := strconv.Atoi(context.Query("at"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested cut position"})
+		return
+	}
+	var remaining int
+	err = service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if playingDeck.Fairness != nil {
+			return errDeckCommitted
+		}
+		if err := playingDeck.Cut(position); err != nil {
+			return err
+		}
+		remaining = playingDeck.Remaining
+		return nil
+	})
+	if !service.handleDeckError(context, err, "unable to cut the deck") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"at":        position,
+		"remaining": remaining,
+	})
+}
+
+// drawCardToPile draws cards from a PlayableDeck associated with a provided ID into one of its
+// named piles, if applicable.
+// Exactly the requested number of cards is drawn, or none, if the strict query parameter is true or
+// if the deck enforces strict draws.
+func (service *deckService) drawCardToPile(context *gin.Context) {
 	requestedDrawCardCount, err := strconv.Atoi(context.Query("count"))
 	if err != nil {
 		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested number of cards to draw"})
 		return
 	}
-	playingDeck := findDeck(id)
-	if playingDeck == nil {
-		context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the deck"})
+	strict, ok := parseStrict(context)
+	if !ok {
+		return
+	}
+	var drawnCards []cards.PlayingCard
+	var remaining int
+	err = service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		var err error
+		if strict || playingDeck.StrictDraw {
+			drawnCards, err = playingDeck.DrawToPileStrictly(context.Param("pile"), requestedDrawCardCount)
+		} else {
+			drawnCards, err = playingDeck.DrawToPile(context.Param("pile"), requestedDrawCardCount)
+		}
+		remaining = playingDeck.Remaining
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to draw cards into the pile") {
 		return
 	}
 	context.JSON(http.StatusOK, gin.H{
-		"cards": playingDeck.DrawCard(requestedDrawCardCount),
+		"cards":     drawnCards,
+		"remaining": remaining,
 	})
 }
 
-// findDeck finds a PlayableDeck associated with id, if any.
-// findDeck returns nil if no PlayableDeck is associated with the provided id.
-func findDeck(id string) *decks.PlayableDeck {
-	for _, playingDeck := range playingDecks {
-		if id == playingDeck.ID.String() {
-			return playingDeck
+// openPile finds a named pile of a PlayableDeck associated with a provided ID, if any.
+// The cards of the pile are sorted according to the ordering provided in the sort query parameter,
+// if any, without reordering the stored pile.
+func (service *deckService) openPile(context *gin.Context) {
+	comparator, ok := parseOrdering(context)
+	if !ok {
+		return
+	}
+	var pile []cards.PlayingCard
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if err := checkOrdering(comparator, playingDeck.Type); err != nil {
+			return err
 		}
+		var err error
+		pile, err = playingDeck.Pile(context.Param("pile"))
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to retrieve the pile") {
+		return
+	}
+	sortCards(pile, comparator)
+	context.JSON(http.StatusOK, gin.H{
+		"name":      context.Param("pile"),
+		"cards":     pile,
+		"remaining": len(pile),
+	})
+}
+
+// drawCardFromPile draws cards from a named pile of a PlayableDeck associated with a provided ID,
+// if applicable.
+// The cards to draw are either the ones provided in the cards query parameter, or the requested
+// number of cards from the top of the pile.
+// The drawn cards are sorted according to the ordering provided in the sort query parameter, if any.
+func (service *deckService) drawCardFromPile(context *gin.Context) {
+	comparator, ok := parseOrdering(context)
+	if !ok {
+		return
+	}
+	pileName := context.Param("pile")
+	var drawPile func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error)
+	if context.Query("cards") != "" {
+		cardCodes, ok := requireCardCodes(context)
+		if !ok {
+			return
+		}
+		drawPile = func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error) {
+			return playingDeck.DrawFromPileByCodes(pileName, cardCodes)
+		}
+	} else {
+		requestedDrawCardCount, err := strconv.Atoi(context.Query("count"))
+		if err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested cards to draw"})
+			return
+		}
+		drawPile = func(playingDeck *decks.PlayableDeck) ([]cards.PlayingCard, error) {
+			return playingDeck.DrawFromPile(pileName, requestedDrawCardCount)
+		}
+	}
+	var drawnCards []cards.PlayingCard
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		if err := checkOrdering(comparator, playingDeck.Type); err != nil {
+			return err
+		}
+		var err error
+		drawnCards, err = drawPile(playingDeck)
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to draw cards from the pile") {
+		return
+	}
+	sortCards(drawnCards, comparator)
+	context.JSON(http.StatusOK, gin.H{
+		"cards": drawnCards,
+	})
+}
+
+// moveCard moves cards from a named pile of a PlayableDeck associated with a provided ID to
+// another pile, if applicable.
+func (service *deckService) moveCard(context *gin.Context) {
+	cardCodes, ok := requireCardCodes(context)
+	if !ok {
+		return
+	}
+	var movedCards []cards.PlayingCard
+	err := service.updateDeck(context.Param("id"), func(playingDeck *decks.PlayableDeck) error {
+		var err error
+		movedCards, err = playingDeck.MoveCards(context.Param("pile"), context.Query("to"), cardCodes)
+		return err
+	})
+	if !service.handleDeckError(context, err, "unable to move cards between piles") {
+		return
+	}
+	context.JSON(http.StatusOK, gin.H{
+		"cards": movedCards,
+	})
+}
+
+// updateDeck applies update to the PlayableDeck associated with id and postpones its expiry.
+// updateDeck fails with errDeckExpired if the PlayableDeck has expired.
+func (service *deckService) updateDeck(id string, update DeckUpdate) error {
+	return service.repository.Update(id, func(playingDeck *decks.PlayableDeck) error {
+		now := time.Now()
+		if playingDeck.IsExpired(now) {
+			return errDeckExpired
+		}
+		playingDeck.Touch(now)
+		return update(playingDeck)
+	})
+}
+
+// handleDeckError writes the error response associated with err, returned upon a deck operation,
+// in context, if any.
+// failureMessage is the message of the response for any unexpected error.
+// handleDeckError returns ok == true if there is no error to handle.
+func (service *deckService) handleDeckError(context *gin.Context, err error, failureMessage string) bool {
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, ErrDeckNotFound) {
+		context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the deck"})
+		return false
+	}
+	if errors.Is(err, errDeckExpired) {
+		context.JSON(http.StatusGone, gin.H{"message": "the deck has expired"})
+		return false
+	}
+	if errors.Is(err, errSeedUnsupported) {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return false
+	}
+	if errors.Is(err, errDeckCommitted) {
+		context.JSON(http.StatusConflict, gin.H{"message": err.Error()})
+		return false
+	}
+	if errors.Is(err, decks.ErrNotProvablyFair) {
+		context.JSON(http.StatusNotFound, gin.H{"message": "the deck has no shuffle commitment"})
+		return false
+	}
+	if errors.Is(err, decks.ErrRevealUnavailable) {
+		context.JSON(http.StatusConflict, gin.H{"message": "the deck can only be revealed once exhausted or closed"})
+		return false
+	}
+	if errors.Is(err, decks.ErrPileNotFound) {
+		context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the pile"})
+		return false
+	}
+	if errors.Is(err, decks.ErrInvalidPileName) {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "the pile name must only contain up to 64 letters, digits, '-' or '_'"})
+		return false
+	}
+	if errors.Is(err, decks.ErrInvalidCutPosition) {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return false
+	}
+	if errors.Is(err, cards.ErrUnsupportedOrdering) {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return false
+	}
+	if errors.Is(err, decks.ErrInvalidDrawCount) {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return false
+	}
+	if respondInvalidCardCodes(context, err) {
+		return false
+	}
+	var insufficientCardsError *decks.InsufficientCardsError
+	if errors.As(err, &insufficientCardsError) {
+		context.JSON(http.StatusConflict, gin.H{
+			"message":   err.Error(),
+			"error":     "insufficient_cards",
+			"requested": insufficientCardsError.Requested,
+			"remaining": insufficientCardsError.Remaining,
+		})
+		return false
+	}
+	if errors.Is(err, decks.ErrCardNotFound) {
+		context.JSON(http.StatusNotFound, gin.H{"message": err.Error()})
+		return false
+	}
+	if errors.Is(err, decks.ErrCardUnavailable) {
+		context.JSON(http.StatusConflict, gin.H{"message": err.Error()})
+		return false
+	}
+	log.Printf("Failed to access the deck: %s", err)
+	context.JSON(http.StatusInternalServerError, gin.H{"message": failureMessage})
+	return false
+}
+
+// respondInvalidCardCodes writes in context the response listing the invalid card codes, if err
+// holds any, along with the reason why every card code is invalid: either it cannot be parsed, or
+// it does not exist in the requested type of deck.
+// respondInvalidCardCodes returns true if the response has been written.
+func respondInvalidCardCodes(context *gin.Context, err error) bool {
+	var invalidCodes []gin.H
+	var codeErrors cards.CodeErrors
+	var unknownCardCodesError *decks.UnknownCardCodesError
+	switch {
+	case errors.As(err, &codeErrors):
+		for _, codeError := range codeErrors {
+			invalidCodes = append(invalidCodes, gin.H{"code": codeError.Token, "error": codeError.Err.Error()})
+		}
+	case errors.As(err, &unknownCardCodesError):
+		for _, code := range unknownCardCodesError.Codes {
+			invalidCodes = append(invalidCodes, gin.H{"code": code, "error": decks.ErrUnknownCardCode.Error()})
+		}
+	default:
+		return false
+	}
+	context.JSON(http.StatusBadRequest, gin.H{
+		"message":       "unable to find the requested card codes",
+		"error":         "invalid_card_codes",
+		"invalid_codes": invalidCodes,
+	})
+	return true
+}
+
+// requireCardCodes parses the card codes provided in the cards query parameter of context.
+// If no card code is provided, requireCardCodes writes the error response in context and
+// returns ok == false.
+func requireCardCodes(context *gin.Context) ([]string, bool) {
+	var cardCodes []string
+	for _, cardCode := range strings.Split(context.Query("cards"), ",") {
+		if cardCode = strings.TrimSpace(cardCode); cardCode != "" {
+			cardCodes = append(cardCodes, cardCode)
+		}
+	}
+	if len(cardCodes) == 0 {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to find the requested cards"})
+		return nil, false
+	}
+	return cardCodes, true
+}
+
+// parseStrict parses the strict query parameter of context, false by default.
+// If the parameter is not a boolean, parseStrict writes the error response in context and returns
+// ok == false.
+func parseStrict(context *gin.Context) (strict bool, ok bool) {
+	rawStrict := context.Query("strict")
+	if rawStrict == "" {
+		return false, true
+	}
+	strict, err := strconv.ParseBool(rawStrict)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "the strict parameter must be a boolean"})
+		return false, false
+	}
+	return strict, true
+}
+
+// parseSeed parses the shuffle seed provided in the seed query parameter of context, if any.
+// If the seed is invalid, parseSeed writes the error response in context and returns ok == false.
+func parseSeed(context *gin.Context) (*int64, bool) {
+	rawSeed := context.Query("seed")
+	if rawSeed == "" {
+		return nil, true
+	}
+	seed, err := strconv.ParseInt(rawSeed, 10, 64)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": "the requested seed must be a 64-bit integer"})
+		return nil, false
+	}
+	return &seed, true
+}
+
+// parseOrdering parses the card ordering provided in the sort query parameter of context, if any.
+// parseOrdering returns a nil cards.Comparator if no ordering is provided.
+// If the ordering is invalid, parseOrdering writes the error response in context and returns
+// ok == false.
+func parseOrdering(context *gin.Context) (cards.Comparator, bool) {
+	specification, present := context.GetQuery("sort")
+	if !present {
+		return nil, true
+	}
+	comparator, err := cards.ParseOrdering(specification)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return nil, false
+	}
+	return comparator, true
+}
+
+// checkOrdering ensures comparator, if any, ranks the cards of a deck of cardType.
+// checkOrdering fails with cards.ErrUnsupportedOrdering if comparator does not support cardType.
+func checkOrdering(comparator cards.Comparator, cardType cards.PlayingCardType) error {
+	if comparator != nil && !comparator.Supports(cardType) {
+		return fmt.Errorf("%w: the requested ordering does not apply to a %s deck", cards.ErrUnsupportedOrdering, cardType)
 	}
 	return nil
 }
+
+// sortCards sorts playingCards according to comparator, if any.
+func sortCards(playingCards []cards.PlayingCard, comparator cards.Comparator) {
+	if comparator != nil {
+		cards.SortCards(playingCards, comparator)
+	}
+}
+
+// parseShuffleSequence parses the shuffle sequence provided in the shuffle_sequence query parameter
+// of context, if any.
+// If the shuffle sequence is invalid, parseShuffleSequence writes the error response in context and
+// returns ok == false.
+func parseShuffleSequence(context *gin.Context) (*string, bool) {
+	shuffleSequence, present := context.GetQuery("shuffle_sequence")
+	if !present {
+		return nil, true
+	}
+	if _, err := decks.ParseShuffleSequence(shuffleSequence); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return nil, false
+	}
+	return &shuffleSequence, true
+}
@@ -11,22 +11,34 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 type CreateResponse struct {
-	DeckID    uuid.UUID `json:"deck_id"`
-	Shuffled  bool      `json:"shuffled"`
-	Remaining int       `json:"remaining"`
+	DeckID    uuid.UUID       `json:"deck_id"`
+	Shuffled  bool            `json:"shuffled"`
+	Seed      *int64          `json:"seed"`
+	Fairness  *decks.Fairness `json:"fairness"`
+	Remaining int             `json:"remaining"`
+	ExpiresAt *time.Time      `json:"expires_at"`
 }
 
 type DrawCardResponse struct {
 	Cards []cards.PlayingCard `json:"cards"`
 }
 
+type PileResponse struct {
+	Name      string              `json:"name"`
+	Cards     []cards.PlayingCard `json:"cards"`
+	Remaining int                 `json:"remaining"`
+}
+
 func TestCreateDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	statusCode, creationResponse := requestCreateDeck(t, router, "", nil)
 	assert.Equal(t, http.StatusCreated, statusCode)
@@ -35,14 +47,132 @@ func TestCreateDeck(t *testing.T) {
 }
 
 func TestCreateDeckWithInvalidBodyRequest(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	statusCode, _ := requestCreateDeck(t, router, "", "invalid body")
 	assert.Equal(t, http.StatusBadRequest, statusCode)
 }
 
+func TestCreateUnoDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "?cards=W4,0B", &decks.CreationRequest{PlayingType: cards.Uno})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 5, creationResponse.Remaining, "expected every copy of the requested cards")
+
+	statusCode, drawResponse := requestCardOperation(t, router, creationResponse.DeckID.String(), "draw", "?cards=W4")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 1, drawResponse.Cards[0].CopyIndex, "expected the first copy to be drawn")
+	statusCode, drawResponse = requestCardOperation(t, router, creationResponse.DeckID.String(), "draw", "?cards=W4")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, 2, drawResponse.Cards[0].CopyIndex, "expected the copies to be told apart")
+}
+
+func TestCreateHanafudaDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{PlayingType: cards.Hanafuda})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 48, creationResponse.Remaining)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/decks/"+creationResponse.DeckID.String(), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Contains(t, responseWriter.Body.String(), `{"value":"CRANE","suit":"PINE","code":"1B","attributes":{"category":"BRIGHT"}}`, "expected the category to be serialized")
+}
+
+func TestCreateDeckWithPoints(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "?cards=AS,QH", &decks.CreationRequest{Points: cards.FrenchCardPoints{"ACE": 11}})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, &cards.CardMetadata{Rank: 1, Color: "BLACK", Face: false, Points: 11}, playingDeck.Cards[0].Metadata)
+	assert.Equal(t, &cards.CardMetadata{Rank: 12, Color: "RED", Face: true, Points: 10}, playingDeck.Cards[1].Metadata)
+
+	statusCode, _ = requestCreateDeck(t, router, "", &decks.CreationRequest{Points: cards.FrenchCardPoints{"KNIGHT": 3}})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestCreateSpanishDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{PlayingType: cards.Spanish, Variant: "40"})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 40, creationResponse.Remaining)
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, cards.Spanish, playingDeck.Type)
+	assert.Equal(t, "40", playingDeck.Variant)
+
+	statusCode, creationResponse = requestCreateDeck(t, router, "?cards=AO,CE,RB", &decks.CreationRequest{PlayingType: cards.Spanish})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	_, playingDeck = requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, "CE", playingDeck.Cards[1].Code)
+	assert.Equal(t, "CABALLO", playingDeck.Cards[1].Value)
+	assert.Equal(t, "ESPADAS", playingDeck.Cards[1].Suit)
+
+	statusCode, _ = requestCreateDeck(t, router, "", &decks.CreationRequest{PlayingType: cards.Spanish, Variant: "52"})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestCreateItalianAndGermanDecks(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	testRecords := []struct {
+		request           decks.CreationRequest
+		expectedRemaining int
+	}{
+		{decks.CreationRequest{PlayingType: cards.Italian}, 40},
+		{decks.CreationRequest{PlayingType: cards.German}, 32},
+		{decks.CreationRequest{PlayingType: cards.German, Variant: "36"}, 36},
+	}
+	for _, testRecord := range testRecords {
+		statusCode, creationResponse := requestCreateDeck(t, router, "", &testRecord.request)
+		assert.Equal(t, http.StatusCreated, statusCode)
+		assert.Equal(t, testRecord.expectedRemaining, creationResponse.Remaining)
+	}
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "?cards=UE,OE", &decks.CreationRequest{PlayingType: cards.German})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 2, creationResponse.Remaining)
+}
+
+func TestCreateTarotDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{PlayingType: cards.Tarot})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 78, creationResponse.Remaining)
+
+	statusCode, creationResponse = requestCreateDeck(t, router, "?cards=T21,EX,CS", &decks.CreationRequest{PlayingType: cards.Tarot})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	id := creationResponse.DeckID.String()
+	_, drawCardResponse := requestDrawCard(t, router, id, "?cards=CS")
+	assert.Equal(t, "KNIGHT", drawCardResponse.Cards[0].Value)
+}
+
+func TestCreateFrenchDeckVariant(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	testRecords := []struct {
+		variant           string
+		expectedStatus    int
+		expectedRemaining int
+	}{
+		{"piquet", http.StatusCreated, 32},
+		{"euchre", http.StatusCreated, 24},
+		{"pinochle", http.StatusCreated, 48},
+		{"bridge", http.StatusBadRequest, 0},
+	}
+	for _, testRecord := range testRecords {
+		statusCode, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{Variant: testRecord.variant})
+		assert.Equal(t, testRecord.expectedStatus, statusCode, "expected status %d for '%s'", testRecord.expectedStatus, testRecord.variant)
+		assert.Equal(t, testRecord.expectedRemaining, creationResponse.Remaining)
+	}
+}
+
 func TestCreateDeckWithUnhandledType(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 	request := decks.CreationRequest{
 		PlayingType: cards.PlayingCardType(99999),
 	}
@@ -51,8 +181,37 @@ func TestCreateDeckWithUnhandledType(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, statusCode)
 }
 
+func TestCreateDeckWithTTL(t *testing.T) {
+	router := NewRouter(Config{DeckTTL: time.Hour}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	testRecords := []struct {
+		ttl                int
+		expectedStatusCode int
+		expectedTTL        time.Duration
+	}{
+		{0, http.StatusCreated, time.Hour},
+		{60, http.StatusCreated, time.Minute},
+		{-1, http.StatusBadRequest, 0},
+	}
+	for _, testRecord := range testRecords {
+		creationTime := time.Now()
+		statusCode, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{TTL: testRecord.ttl})
+		assert.Equal(t, testRecord.expectedStatusCode, statusCode)
+		if testRecord.expectedStatusCode == http.StatusCreated {
+			assert.WithinDuration(t, creationTime.Add(testRecord.expectedTTL), *creationResponse.ExpiresAt, time.Second)
+		}
+	}
+}
+
+func TestCreateDeckWithoutExpiry(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", nil)
+	assert.Nil(t, creationResponse.ExpiresAt, "expected a deck which never expires")
+}
+
 func TestCreateShuffledDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 	request := decks.CreationRequest{
 		Shuffled: true,
 	}
@@ -63,8 +222,159 @@ func TestCreateShuffledDeck(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, statusCode)
 }
 
+func TestCreateShoe(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Count: 6, Shuffled: true})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 312, creationResponse.Remaining)
+
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	for _, card := range playingDeck.Cards {
+		assert.True(t, card.DeckIndex >= 1 && card.DeckIndex <= 6, "expected the cards to be traceable to their deck")
+	}
+
+	statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{Count: decks.MaxDeckCount + 1})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestCreateSeededDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	seed := int64(1234)
+
+	_, firstCreationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, Seed: &seed})
+	_, secondCreationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, Seed: &seed})
+	assert.Equal(t, seed, *firstCreationResponse.Seed)
+
+	_, firstDeck := requestOpenDeck(t, router, firstCreationResponse.DeckID.String())
+	_, secondDeck := requestOpenDeck(t, router, secondCreationResponse.DeckID.String())
+	assert.Equal(t, firstDeck.Cards, secondDeck.Cards, "expected identical orders out of the same seed")
+
+	_, randomCreationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true})
+	assert.NotNil(t, randomCreationResponse.Seed, "expected the random seed to be returned")
+	_, replayedCreationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, Seed: randomCreationResponse.Seed})
+	_, randomDeck := requestOpenDeck(t, router, randomCreationResponse.DeckID.String())
+	_, replayedDeck := requestOpenDeck(t, router, replayedCreationResponse.DeckID.String())
+	assert.Equal(t, randomDeck.Cards, replayedDeck.Cards, "expected the shuffle to be replayed")
+}
+
+func TestCreateSecureDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	seed := int64(1234)
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, ShuffleMode: decks.SecureShuffle})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.True(t, creationResponse.Shuffled, "expected a shuffled deck")
+	assert.Nil(t, creationResponse.Seed, "expected no seed for a secure shuffle")
+
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, decks.SecureShuffle, playingDeck.ShuffleMode)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/discard/reshuffle?seed=42", playingDeck.ID), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+
+	id := playingDeck.ID.String()
+	requestDrawCard(t, router, id, "?count=2")
+	requestCardOperation(t, router, id, "discard", "?cards="+playingDeck.Cards[0].Code)
+	statusCode = requestDeckOperation(t, router, id, "discard/reshuffle", "")
+	assert.Equal(t, http.StatusOK, statusCode)
+	_, playingDeck = requestOpenDeck(t, router, id)
+	assert.Equal(t, 51, playingDeck.Remaining)
+	assert.Nil(t, playingDeck.Seed, "expected no seed for a secure reshuffle")
+	for _, operation := range playingDeck.History {
+		assert.Nil(t, operation.Seed, "expected no seed to be recorded for a secure deck")
+	}
+
+	statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, ShuffleMode: decks.SecureShuffle, Seed: &seed})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{ShuffleMode: "lucky"})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestProvablyFairDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, ProvablyFair: true, ClientSeed: "lucky"})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Nil(t, creationResponse.Seed, "expected the shuffle seed to be kept secret")
+	commitment := creationResponse.Fairness.Commitment
+	assert.Equal(t, &decks.Fairness{Commitment: commitment, ClientSeed: "lucky"}, creationResponse.Fairness, "expected the secrets to be concealed")
+	id := creationResponse.DeckID.String()
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, &decks.Fairness{Commitment: commitment, ClientSeed: "lucky"}, playingDeck.Fairness, "expected the secrets to be concealed")
+
+	statusCode, _ = requestRevealDeck(t, router, id)
+	assert.Equal(t, http.StatusConflict, statusCode)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/close", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusOK, responseWriter.Code)
+
+	statusCode, fairness := requestRevealDeck(t, router, id)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, commitment, fairness.Commitment)
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+	for i, card := range playingDeck.Cards {
+		assert.Equal(t, card.Code, fairness.Order[i], "expected the commitment to cover the order of the cards")
+	}
+}
+
+func TestReorderProvablyFairDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, ProvablyFair: true, ClientSeed: "lucky"})
+	id := creationResponse.DeckID.String()
+	_, expectedDeck := requestOpenDeck(t, router, id)
+
+	for _, query := range []string{"", "?seed=42", "?remaining=true"} {
+		statusCode := requestDeckOperation(t, router, id, "shuffle", query)
+		assert.Equal(t, http.StatusConflict, statusCode, "expected a conflict for '%s'", query)
+	}
+	statusCode := requestDeckOperation(t, router, id, "cut", "?at=26")
+	assert.Equal(t, http.StatusConflict, statusCode)
+	requestDrawCard(t, router, id, "?count=2")
+	requestCardOperation(t, router, id, "discard", "?cards="+expectedDeck.Cards[0].Code)
+	for _, query := range []string{"", "?seed=42"} {
+		statusCode = requestDeckOperation(t, router, id, "discard/reshuffle", query)
+		assert.Equal(t, http.StatusConflict, statusCode, "expected a conflict for '%s'", query)
+	}
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, expectedDeck.Cards[2:], playingDeck.Cards, "expected the order of the deck to be untouched")
+	assert.Equal(t, expectedDeck.Cards[:1], playingDeck.Discarded, "expected the discard pile to be untouched")
+	assert.Nil(t, playingDeck.Seed, "expected the deck not to be seeded")
+	assert.Equal(t, expectedDeck.History, playingDeck.History, "expected no operation to be recorded")
+
+	requestDeckOperation(t, router, id, "close", "")
+	statusCode, fairness := requestRevealDeck(t, router, id)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.True(t, fairness.Verify(), "expected a verifiable commitment")
+	for i, card := range expectedDeck.Cards {
+		assert.Equal(t, card.Code, fairness.Order[i], "expected the commitment to cover the order of the cards")
+	}
+}
+
+func TestRevealDeckWithoutCommitment(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS", decks.CreationRequest{Shuffled: true})
+	requestDrawCard(t, router, creationResponse.DeckID.String(), "?count=1")
+
+	statusCode, _ := requestRevealDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{ProvablyFair: true})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true, ClientSeed: "lucky"})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
 func TestCreateCustomDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 	requestedCardCodes := []string{"AS", "KD", "AC", "2C", "KH"}
 
 	statusCode, sortedDeck := requestCreateDeck(t, router, "?cards="+strings.Join(requestedCardCodes, ","), nil)
@@ -73,8 +383,25 @@ func TestCreateCustomDeck(t *testing.T) {
 	assert.Equal(t, 5, sortedDeck.Remaining, "expected a non-empty decks upon creation")
 }
 
+func TestCreateDeckWithJokers(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Jokers: true})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 54, creationResponse.Remaining)
+
+	statusCode, creationResponse = requestCreateDeck(t, router, "?cards=AS,JR,JB", nil)
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 3, creationResponse.Remaining)
+
+	for _, cardType := range []cards.PlayingCardType{cards.Spanish, cards.German, cards.Uno} {
+		statusCode, _ = requestCreateDeck(t, router, "", decks.CreationRequest{PlayingType: cardType, Jokers: true})
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected the jokers to be rejected for a %s deck", cardType)
+	}
+}
+
 func TestOpenDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	_, creationResponse := requestCreateDeck(t, router, "", nil)
 
@@ -85,19 +412,19 @@ func TestOpenDeck(t *testing.T) {
 }
 
 func TestOpenUnknownDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	statusCode, _ := requestOpenDeck(t, router, "unknown_id")
 	assert.Equal(t, http.StatusNotFound, statusCode)
 }
 
 func TestDrawCard(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	requestedCardCodes := []string{"AS", "2S", "3S"}
-	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS"}
-	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S"}
-	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S"}
+	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", Metadata: &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}}
+	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S", Metadata: &cards.CardMetadata{Rank: 2, Color: "BLACK", Points: 2}}
+	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S", Metadata: &cards.CardMetadata{Rank: 3, Color: "BLACK", Points: 3}}
 
 	_, creationResponse := requestCreateDeck(t, router, "?cards="+strings.Join(requestedCardCodes, ","), nil)
 	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
@@ -117,15 +444,659 @@ func TestDrawCard(t *testing.T) {
 		playingDeck.Cards)
 }
 
+func TestOpenExpiredDeck(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	router := NewRouter(Config{DeckTTL: time.Hour}, repository, NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", nil)
+	expireDeck(t, repository, creationResponse.DeckID.String())
+
+	statusCode, _ := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, http.StatusGone, statusCode)
+
+	statusCode, _ = requestDrawCard(t, router, creationResponse.DeckID.String(), "?count=1")
+	assert.Equal(t, http.StatusGone, statusCode)
+}
+
+func TestOpenDeckPostponesExpiry(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	router := NewRouter(Config{DeckTTL: time.Hour}, repository, NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", nil)
+	_ = repository.Update(creationResponse.DeckID.String(), func(deck *decks.PlayableDeck) error {
+		deck.Touch(time.Now().Add(-30 * time.Minute))
+		return nil
+	})
+
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *playingDeck.ExpiresAt, time.Second)
+}
+
+func TestDeleteDeck(t *testing.T) {
+	repository := NewMemoryDeckRepository()
+	router := NewRouter(Config{DeckTTL: time.Hour}, repository, NewMemoryTemplateRepository())
+
+	_, firstCreationResponse := requestCreateDeck(t, router, "", nil)
+	_, secondCreationResponse := requestCreateDeck(t, router, "", nil)
+	expireDeck(t, repository, secondCreationResponse.DeckID.String())
+
+	for _, id := range []string{firstCreationResponse.DeckID.String(), secondCreationResponse.DeckID.String()} {
+		assert.Equal(t, http.StatusNoContent, requestDeleteDeck(router, id))
+
+		statusCode, _ := requestOpenDeck(t, router, id)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+		assert.Equal(t, http.StatusNotFound, requestDeleteDeck(router, id))
+	}
+}
+
+func TestRoutersDoNotShareDecks(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	otherRouter := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", nil)
+	statusCode, _ := requestOpenDeck(t, otherRouter, creationResponse.DeckID.String())
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestConcurrentDrawCard(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "", decks.CreationRequest{Shuffled: true})
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+
+	drawnCards := make(chan cards.PlayingCard, len(playingDeck.Cards))
+	var waitGroup sync.WaitGroup
+	for i := 0; i < len(playingDeck.Cards); i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			_, drawCardResponse := requestDrawCard(t, router, playingDeck.ID.String(), "?count=1")
+			for _, card := range drawCardResponse.Cards {
+				drawnCards <- card
+			}
+		}()
+	}
+	waitGroup.Wait()
+	close(drawnCards)
+
+	var actualCards []cards.PlayingCard
+	for card := range drawnCards {
+		actualCards = append(actualCards, card)
+	}
+	assert.ElementsMatch(t, playingDeck.Cards, actualCards, "expected every card to be dealt exactly once")
+
+	_, playingDeck = requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Zero(t, playingDeck.Remaining, "expected no remaining cards")
+}
+
+func TestDiscardAndReturnCard(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", Metadata: &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}}
+	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S", Metadata: &cards.CardMetadata{Rank: 2, Color: "BLACK", Points: 2}}
+	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S", Metadata: &cards.CardMetadata{Rank: 3, Color: "BLACK", Points: 3}}
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+	requestDrawCard(t, router, id, "?count=2")
+
+	statusCode, cardsResponse := requestCardOperation(t, router, id, "discard", "?cards=AS")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades}, cardsResponse.Cards)
+
+	statusCode, cardsResponse = requestCardOperation(t, router, id, "return", "?cards=AS,2S&position=bottom")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades}, cardsResponse.Cards)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, aceOfSpades, twoOfSpades}, playingDeck.Cards)
+	assert.Equal(t, 3, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Drawn, "expected no drawn cards")
+	assert.Empty(t, playingDeck.Discarded, "expected an empty discard pile")
+}
+
+func TestInvalidCardOperations(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+	requestDrawCard(t, router, id, "?count=1")
+
+	testRecords := []struct {
+		operation          string
+		queryParameters    string
+		expectedStatusCode int
+	}{
+		{"discard", "", http.StatusBadRequest},
+		{"discard", "?cards=2S", http.StatusConflict},
+		{"discard", "?cards=KH", http.StatusConflict},
+		{"return", "?cards= , ", http.StatusBadRequest},
+		{"return", "?cards=AS&position=middle", http.StatusBadRequest},
+		{"return", "?cards=AS,AS", http.StatusConflict},
+	}
+	for _, testRecord := range testRecords {
+		statusCode, _ := requestCardOperation(t, router, id, testRecord.operation, testRecord.queryParameters)
+		assert.Equal(t, testRecord.expectedStatusCode, statusCode, "unexpected status for %s%s", testRecord.operation, testRecord.queryParameters)
+	}
+	statusCode, _ := requestCardOperation(t, router, "unknown_id", "discard", "?cards=AS")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestReshuffleDiscarded(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+	requestDrawCard(t, router, id, "?count=2")
+	requestCardOperation(t, router, id, "discard", "?cards=AS,2S")
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/discard/reshuffle?seed=42", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusOK, responseWriter.Code)
+
+	responseWriter = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/decks/%s/discard/reshuffle?seed=forty-two", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 3, playingDeck.Remaining)
+	assert.True(t, playingDeck.Shuffled, "expected a shuffled deck")
+	assert.Equal(t, int64(42), *playingDeck.Seed)
+	assert.Empty(t, playingDeck.Discarded, "expected an empty discard pile")
+}
+
+func TestReshuffleDiscardedWithShuffleSequence(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", map[string]interface{}{"shuffled": true, "shuffle_sequence": "riffle x7, cut"})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	id := creationResponse.DeckID.String()
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, "riffle x7, cut", playingDeck.ShuffleSequence)
+
+	statusCode, _ = requestCreateDeck(t, router, "", map[string]interface{}{"shuffled": true, "shuffle_sequence": "shake"})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/discard/reshuffle?shuffle_sequence=overhand+x5", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusOK, responseWriter.Code)
+
+	responseWriter = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/decks/%s/discard/reshuffle?shuffle_sequence=shake", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+
+	_, playingDeck = requestOpenDeck(t, router, id)
+	assert.Equal(t, "overhand x5", playingDeck.ShuffleSequence)
+	assert.Equal(t, 52, playingDeck.Remaining)
+}
+
+func TestShuffleDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S,4S", nil)
+	id := creationResponse.DeckID.String()
+	requestDrawCard(t, router, id, "?count=1")
+	requestPileOperation(t, router, id, "player1", "add", "?count=1")
+
+	statusCode := requestDeckOperation(t, router, id, "shuffle", "?remaining=true&seed=42")
+	assert.Equal(t, http.StatusOK, statusCode)
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 2, playingDeck.Remaining)
+	assert.True(t, playingDeck.Shuffled, "expected a shuffled deck")
+	assert.Equal(t, int64(42), *playingDeck.Seed)
+
+	statusCode = requestDeckOperation(t, router, id, "shuffle", "?shuffle_sequence=riffle+x7")
+	assert.Equal(t, http.StatusOK, statusCode)
+	_, playingDeck = requestOpenDeck(t, router, id)
+	assert.Equal(t, 4, playingDeck.Remaining)
+	assert.Empty(t, playingDeck.Drawn, "expected the drawn cards to be gathered")
+	assert.Empty(t, playingDeck.Piles, "expected the piles to be gathered")
+	assert.Equal(t, "riffle x7", playingDeck.ShuffleSequence)
+	expectedTypes := []decks.OperationType{decks.ShuffleOperation, decks.GatherOperation, decks.ShuffleOperation}
+	for i, operation := range playingDeck.History {
+		assert.Equal(t, expectedTypes[i], operation.Type, "expected the operations to be recorded in order")
+	}
+	assert.Len(t, playingDeck.History, len(expectedTypes))
+
+	for _, query := range []string{"?remaining=maybe", "?seed=forty-two", "?shuffle_sequence=shake"} {
+		statusCode = requestDeckOperation(t, router, id, "shuffle", query)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s'", query)
+	}
+	statusCode = requestDeckOperation(t, router, uuid.New().String(), "shuffle", "")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestCutDeck(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S,4S", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode := requestDeckOperation(t, router, id, "cut", "?at=3")
+	assert.Equal(t, http.StatusOK, statusCode)
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, "4S", playingDeck.Cards[0].Code, "expected the bottom part on top")
+	assert.Equal(t, []decks.Operation{{Type: decks.CutOperation, At: 3}}, playingDeck.History, "expected the cut to be recorded")
+
+	for _, query := range []string{"", "?at=top", "?at=0", "?at=4"} {
+		statusCode = requestDeckOperation(t, router, id, "cut", query)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s'", query)
+	}
+	statusCode = requestDeckOperation(t, router, uuid.New().String(), "cut", "?at=1")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestDrawCardModes(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S,4S,5S", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode, drawCardResponse := requestDrawCard(t, router, id, "?count=1&from=bottom")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "5S", drawCardResponse.Cards[0].Code, "expected the bottom card")
+
+	statusCode, drawCardResponse = requestDrawCard(t, router, id, "?cards=3S")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "3S", drawCardResponse.Cards[0].Code, "expected the requested card")
+
+	statusCode, drawCardResponse = requestDrawCard(t, router, id, "?count=1&from=random")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Contains(t, []string{"AS", "2S", "4S"}, drawCardResponse.Cards[0].Code, "expected a remaining card")
+
+	statusCode, _ = requestDrawCard(t, router, id, "?cards=3S")
+	assert.Equal(t, http.StatusConflict, statusCode)
+	statusCode, _ = requestDrawCard(t, router, id, "?cards=KH")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+	statusCode, _ = requestDrawCard(t, router, id, "?count=1&from=middle")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 2, playingDeck.Remaining)
+}
+
+func TestStrictDrawCard(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode, drawCardResponse := requestDrawCard(t, router, id, "?count=2&strict=true")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Len(t, drawCardResponse.Cards, 2)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/cards/draw?count=2&strict=true", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusConflict, responseWriter.Code)
+	var errorResponse struct {
+		Error     string `json:"error"`
+		Requested int    `json:"requested"`
+		Remaining int    `json:"remaining"`
+	}
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &errorResponse); err != nil {
+		t.Fail()
+	}
+	assert.Equal(t, "insufficient_cards", errorResponse.Error)
+	assert.Equal(t, 2, errorResponse.Requested)
+	assert.Equal(t, 1, errorResponse.Remaining)
+
+	for _, query := range []string{"?count=0&strict=true", "?count=-1&strict=true", "?count=1&strict=maybe"} {
+		statusCode, _ = requestDrawCard(t, router, id, query)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s'", query)
+	}
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 1, playingDeck.Remaining, "expected the rejected draws to draw no card")
+
+	statusCode, drawCardResponse = requestDrawCard(t, router, id, "?count=2")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Len(t, drawCardResponse.Cards, 1, "expected a lenient draw by default")
+}
+
+func TestStrictDrawPolicy(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S", map[string]interface{}{"strict_draw": true})
+	id := creationResponse.DeckID.String()
+
+	statusCode, _ := requestDrawCard(t, router, id, "?count=3")
+	assert.Equal(t, http.StatusConflict, statusCode)
+	statusCode, _ = requestDrawCard(t, router, id, "?count=0")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.True(t, playingDeck.StrictDraw, "expected a strict draw policy")
+	assert.Equal(t, 2, playingDeck.Remaining, "expected the rejected draws to draw no card")
+}
+
+func TestStrictDrawCardToPile(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode, drawCardResponse := requestPileOperation(t, router, id, "player1", "add", "?count=2&strict=true")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Len(t, drawCardResponse.Cards, 2)
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/piles/player1/add?count=2&strict=true", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusConflict, responseWriter.Code)
+	var errorResponse struct {
+		Error     string `json:"error"`
+		Requested int    `json:"requested"`
+		Remaining int    `json:"remaining"`
+	}
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &errorResponse); err != nil {
+		t.Fail()
+	}
+	assert.Equal(t, "insufficient_cards", errorResponse.Error)
+	assert.Equal(t, 2, errorResponse.Requested)
+	assert.Equal(t, 1, errorResponse.Remaining)
+
+	for _, query := range []string{"?count=0&strict=true", "?count=-1&strict=true", "?count=1&strict=maybe"} {
+		statusCode, _ = requestPileOperation(t, router, id, "player1", "add", query)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s'", query)
+	}
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 1, playingDeck.Remaining, "expected the rejected draws to draw no card")
+
+	statusCode, drawCardResponse = requestPileOperation(t, router, id, "player1", "add", "?count=2")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Len(t, drawCardResponse.Cards, 1, "expected a lenient draw by default")
+}
+
+func TestStrictDrawPolicyToPile(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S", map[string]interface{}{"strict_draw": true})
+	id := creationResponse.DeckID.String()
+
+	statusCode, _ := requestPileOperation(t, router, id, "player1", "add", "?count=3")
+	assert.Equal(t, http.StatusConflict, statusCode)
+	statusCode, _ = requestPileOperation(t, router, id, "player1", "add", "?count=0")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 2, playingDeck.Remaining, "expected the rejected draws to draw no card")
+	assert.Empty(t, playingDeck.Piles, "expected no pile to be created")
+}
+
+func TestPeekCard(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/decks/%s/cards/peek?count=2", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusOK, responseWriter.Code)
+	var peekResponse DrawCardResponse
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &peekResponse); err != nil {
+		t.Fail()
+	}
+	assert.Len(t, peekResponse.Cards, 2)
+	assert.Equal(t, "AS", peekResponse.Cards[0].Code)
+
+	responseWriter = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", fmt.Sprintf("/decks/%s/cards/peek?count=two", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, 3, playingDeck.Remaining, "expected no card to be drawn")
+}
+
+func TestSortCards(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	cardCodes := func(playingCards []cards.PlayingCard) []string {
+		codes := make([]string, 0, len(playingCards))
+		for _, card := range playingCards {
+			codes = append(codes, card.Code)
+		}
+		return codes
+	}
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,JH,2S,KD,JD,10H,9S,QC", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode, drawCardResponse := requestDrawCard(t, router, id, "?count=3&sort=ace-high")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []string{"2S", "JH", "AS"}, cardCodes(drawCardResponse.Cards))
+
+	statusCode, drawCardResponse = requestDrawCard(t, router, id, "?count=2&sort=ace-low")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []string{"JD", "KD"}, cardCodes(drawCardResponse.Cards))
+
+	statusCode, _ = requestPileOperation(t, router, id, "player1", "add", "?count=3")
+	assert.Equal(t, http.StatusOK, statusCode)
+	statusCode, pileResponse := requestOpenPile(t, router, id, "player1?sort=euchre:hearts")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []string{"9S", "QC", "10H"}, cardCodes(pileResponse.Cards))
+	_, pileResponse = requestOpenPile(t, router, id, "player1")
+	assert.Equal(t, []string{"10H", "9S", "QC"}, cardCodes(pileResponse.Cards), "expected the stored pile to be untouched")
+
+	statusCode, drawCardResponse = requestPileOperation(t, router, id, "player1", "draw", "?count=3&sort=skat:hearts")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []string{"9S", "QC", "10H"}, cardCodes(drawCardResponse.Cards))
+
+	statusCode, _ = requestDrawCard(t, router, id, "?count=1&sort=bridge")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+	statusCode, _ = requestOpenPile(t, router, id, "player1?sort=euchre")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestSortNonFrenchCards(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AE,UE,7G,US", decks.CreationRequest{PlayingType: cards.German})
+	id := creationResponse.DeckID.String()
+	statusCode, drawCardResponse := requestDrawCard(t, router, id, "?count=4&sort=skat:eichel")
+	assert.Equal(t, http.StatusOK, statusCode)
+	codes := make([]string, 0, len(drawCardResponse.Cards))
+	for _, card := range drawCardResponse.Cards {
+		codes = append(codes, card.Code)
+	}
+	assert.Equal(t, []string{"7G", "AE", "US", "UE"}, codes, "expected the German cards to be sorted by the Skat rules")
+
+	testRecords := []struct {
+		request decks.CreationRequest
+		sort    string
+	}{
+		{decks.CreationRequest{PlayingType: cards.German}, "ace-high"},
+		{decks.CreationRequest{PlayingType: cards.German}, "skat:clubs"},
+		{decks.CreationRequest{PlayingType: cards.Spanish}, "skat"},
+		{decks.CreationRequest{PlayingType: cards.Uno}, "ace-low"},
+		{decks.CreationRequest{PlayingType: cards.Hanafuda}, "euchre:hearts"},
+	}
+	for _, testRecord := range testRecords {
+		_, creationResponse = requestCreateDeck(t, router, "", testRecord.request)
+		id = creationResponse.DeckID.String()
+		requestPileOperation(t, router, id, "player1", "add", "?count=2")
+
+		statusCode, _ = requestDrawCard(t, router, id, "?count=2&sort="+testRecord.sort)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s' on a %s deck", testRecord.sort, testRecord.request.PlayingType)
+		responseWriter := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", fmt.Sprintf("/decks/%s/cards/peek?count=2&sort=%s", id, testRecord.sort), nil)
+		router.ServeHTTP(responseWriter, request)
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code, "expected a bad request for '%s' on a %s deck", testRecord.sort, testRecord.request.PlayingType)
+		statusCode, _ = requestOpenPile(t, router, id, "player1?sort="+testRecord.sort)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s' on a %s pile", testRecord.sort, testRecord.request.PlayingType)
+		statusCode, _ = requestPileOperation(t, router, id, "player1", "draw", "?count=2&sort="+testRecord.sort)
+		assert.Equal(t, http.StatusBadRequest, statusCode, "expected a bad request for '%s' on a %s pile", testRecord.sort, testRecord.request.PlayingType)
+		_, playingDeck := requestOpenDeck(t, router, id)
+		assert.Len(t, playingDeck.Piles["player1"], 2, "expected the rejected draws to draw no card")
+		assert.Empty(t, playingDeck.Drawn, "expected the rejected draws to draw no card")
+	}
+}
+
+func TestCardCodeAliases(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "?cards="+url.QueryEscape("as,TH,q♦,ace of spades,red joker"), nil)
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 4, creationResponse.Remaining, "expected the duplicated aliases to be refined")
+	id := creationResponse.DeckID.String()
+
+	statusCode, drawCardResponse := requestCardOperation(t, router, id, "draw", "?cards="+url.QueryEscape("10h,A♠"))
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "10H", drawCardResponse.Cards[0].Code)
+	assert.Equal(t, "AS", drawCardResponse.Cards[1].Code)
+
+	statusCode, drawCardResponse = requestCardOperation(t, router, id, "discard", "?cards="+url.QueryEscape("ten of hearts"))
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, "10H", drawCardResponse.Cards[0].Code)
+}
+
+func TestInvalidCardCodes(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	expectedInvalidCodes := []map[string]string{
+		{"code": "1S", "error": cards.ErrUnknownValue.Error()},
+		{"code": "KX", "error": cards.ErrUnknownSuit.Error()},
+	}
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/decks?cards=AS,1S,KX", bytes.NewBufferString("{}"))
+	router.ServeHTTP(responseWriter, request)
+	var response struct {
+		InvalidCodes []map[string]string `json:"invalid_codes"`
+	}
+	assert.Nil(t, json.Unmarshal(responseWriter.Body.Bytes(), &response), "expected a JSON response")
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+	assert.Equal(t, expectedInvalidCodes, response.InvalidCodes, "expected an error per invalid card code")
+
+	_, creationResponse := requestCreateDeck(t, router, "", nil)
+	id := creationResponse.DeckID.String()
+	responseWriter = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", fmt.Sprintf("/decks/%s/cards/draw?cards=AS,1S,KX", id), nil)
+	router.ServeHTTP(responseWriter, request)
+	response.InvalidCodes = nil
+	assert.Nil(t, json.Unmarshal(responseWriter.Body.Bytes(), &response), "expected a JSON response")
+	assert.Equal(t, http.StatusBadRequest, responseWriter.Code)
+	assert.Equal(t, expectedInvalidCodes, response.InvalidCodes, "expected an error per invalid card code")
+
+	_, openResponse := requestOpenDeck(t, router, id)
+	assert.Equal(t, 52, openResponse.Remaining, "expected the deck to be untouched")
+}
+
+func TestUnknownCardCodes(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	testRecords := []struct {
+		query                string
+		request              decks.CreationRequest
+		expectedInvalidCodes []map[string]string
+	}{
+		{"?cards=AS,2S,3S", decks.CreationRequest{Variant: decks.FrenchPiquet}, []map[string]string{
+			{"code": "2S", "error": decks.ErrUnknownCardCode.Error()},
+			{"code": "3S", "error": decks.ErrUnknownCardCode.Error()},
+		}},
+		{"?cards=2S", decks.CreationRequest{Variant: decks.FrenchEuchre}, []map[string]string{
+			{"code": "2S", "error": decks.ErrUnknownCardCode.Error()},
+		}},
+		{"?cards=ZZ", decks.CreationRequest{PlayingType: cards.Spanish}, []map[string]string{
+			{"code": "ZZ", "error": decks.ErrUnknownCardCode.Error()},
+		}},
+		{"?cards=ZZ", decks.CreationRequest{PlayingType: cards.Italian}, []map[string]string{
+			{"code": "ZZ", "error": decks.ErrUnknownCardCode.Error()},
+		}},
+		{"?cards=ZZ", decks.CreationRequest{PlayingType: cards.German}, []map[string]string{
+			{"code": "ZZ", "error": decks.ErrUnknownCardCode.Error()},
+		}},
+	}
+	for _, testRecord := range testRecords {
+		body, _ := json.Marshal(testRecord.request)
+		responseWriter := httptest.NewRecorder()
+		request, _ := http.NewRequest("POST", "/decks"+testRecord.query, bytes.NewBuffer(body))
+		router.ServeHTTP(responseWriter, request)
+		var response struct {
+			InvalidCodes []map[string]string `json:"invalid_codes"`
+		}
+		assert.Nil(t, json.Unmarshal(responseWriter.Body.Bytes(), &response), "expected a JSON response")
+		assert.Equal(t, http.StatusBadRequest, responseWriter.Code, "expected a bad request for '%s'", testRecord.query)
+		assert.Equal(t, testRecord.expectedInvalidCodes, response.InvalidCodes, "expected every unknown card code for '%s'", testRecord.query)
+	}
+}
+
+func TestPiles(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	aceOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "ACE", Code: "AS", Metadata: &cards.CardMetadata{Rank: 1, Color: "BLACK", Points: 1}}
+	twoOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "2", Code: "2S", Metadata: &cards.CardMetadata{Rank: 2, Color: "BLACK", Points: 2}}
+	threeOfSpades := cards.PlayingCard{Suit: cards.Spades.String(), Value: "3", Code: "3S", Metadata: &cards.CardMetadata{Rank: 3, Color: "BLACK", Points: 3}}
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S,4S", nil)
+	id := creationResponse.DeckID.String()
+
+	statusCode, cardsResponse := requestPileOperation(t, router, id, "player1", "add", "?count=3")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{aceOfSpades, twoOfSpades, threeOfSpades}, cardsResponse.Cards)
+
+	statusCode, cardsResponse = requestPileOperation(t, router, id, "player1", "move", "?to=board&cards=2S")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, cardsResponse.Cards)
+
+	statusCode, cardsResponse = requestPileOperation(t, router, id, "player1", "draw", "?cards=3S")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades}, cardsResponse.Cards)
+
+	statusCode, cardsResponse = requestPileOperation(t, router, id, "board", "draw", "?count=1")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []cards.PlayingCard{twoOfSpades}, cardsResponse.Cards)
+
+	statusCode, pileResponse := requestOpenPile(t, router, id, "player1")
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, PileResponse{Name: "player1", Cards: []cards.PlayingCard{aceOfSpades}, Remaining: 1}, pileResponse)
+
+	_, playingDeck := requestOpenDeck(t, router, id)
+	assert.Equal(t, map[string][]cards.PlayingCard{"player1": {aceOfSpades}, "board": {}}, playingDeck.Piles)
+	assert.Equal(t, []cards.PlayingCard{threeOfSpades, twoOfSpades}, playingDeck.Drawn)
+	assert.Equal(t, 1, playingDeck.Remaining)
+}
+
+func TestInvalidPileOperations(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	_, creationResponse := requestCreateDeck(t, router, "?cards=AS,2S,3S", nil)
+	id := creationResponse.DeckID.String()
+	requestPileOperation(t, router, id, "player1", "add", "?count=1")
+
+	testRecords := []struct {
+		pileName           string
+		operation          string
+		queryParameters    string
+		expectedStatusCode int
+	}{
+		{"player1", "add", "", http.StatusBadRequest},
+		{"player_1!", "add", "?count=1", http.StatusBadRequest},
+		{"player1", "draw", "", http.StatusBadRequest},
+		{"player2", "draw", "?count=1", http.StatusNotFound},
+		{"player1", "draw", "?cards=2S", http.StatusConflict},
+		{"player1", "move", "?to=board", http.StatusBadRequest},
+		{"player1", "move", "?cards=AS", http.StatusBadRequest},
+		{"player2", "move", "?to=board&cards=AS", http.StatusNotFound},
+		{"player1", "move", "?to=board&cards=2S", http.StatusConflict},
+	}
+	for _, testRecord := range testRecords {
+		statusCode, _ := requestPileOperation(t, router, id, testRecord.pileName, testRecord.operation, testRecord.queryParameters)
+		assert.Equal(t, testRecord.expectedStatusCode, statusCode, "unexpected status for %s/%s%s", testRecord.pileName, testRecord.operation, testRecord.queryParameters)
+	}
+	statusCode, _ := requestOpenPile(t, router, id, "player2")
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
 func TestDrawCardFromUnknownDeck(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	statusCode, _ := requestDrawCard(t, router, "2", "?count=1")
 	assert.Equal(t, http.StatusNotFound, statusCode)
 }
 
 func TestDrawCardFromDeckWithInvalidCount(t *testing.T) {
-	router := NewRouter()
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
 
 	requestedDrawCardCount := []string{"", "a12"}
 	for _, requestedDrawCardCount := range requestedDrawCardCount {
@@ -151,6 +1122,13 @@ func requestCreateDeck(t *testing.T, router *gin.Engine, queryParameters string,
 	return responseWriter.Code, response
 }
 
+func requestDeckOperation(t *testing.T, router *gin.Engine, id string, operation string, queryParameters string) int {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/%s%s", id, operation, queryParameters), nil)
+	router.ServeHTTP(responseWriter, request)
+	return responseWriter.Code
+}
+
 func requestOpenDeck(t *testing.T, router *gin.Engine, id string) (int, decks.PlayableDeck) {
 	responseWriter := httptest.NewRecorder()
 	request, _ := http.NewRequest("GET", "/decks/"+id, nil)
@@ -163,6 +1141,61 @@ func requestOpenDeck(t *testing.T, router *gin.Engine, id string) (int, decks.Pl
 	return responseWriter.Code, actualPlayingDeck
 }
 
+func requestCardOperation(t *testing.T, router *gin.Engine, id string, operation string, queryParameters string) (int, DrawCardResponse) {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/cards/%s%s", id, operation, queryParameters), nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var cardsResponse DrawCardResponse
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &cardsResponse); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, cardsResponse
+}
+
+func requestPileOperation(t *testing.T, router *gin.Engine, id string, pileName string, operation string, queryParameters string) (int, DrawCardResponse) {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/piles/%s/%s%s", id, pileName, operation, queryParameters), nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var cardsResponse DrawCardResponse
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &cardsResponse); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, cardsResponse
+}
+
+func requestOpenPile(t *testing.T, router *gin.Engine, id string, pileName string) (int, PileResponse) {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/decks/%s/piles/%s", id, pileName), nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var pileResponse PileResponse
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &pileResponse); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, pileResponse
+}
+
+func requestRevealDeck(t *testing.T, router *gin.Engine, id string) (int, decks.Fairness) {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", fmt.Sprintf("/decks/%s/reveal", id), nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var fairness decks.Fairness
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &fairness); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, fairness
+}
+
+func requestDeleteDeck(router *gin.Engine, id string) int {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/decks/"+id, nil)
+	router.ServeHTTP(responseWriter, request)
+	return responseWriter.Code
+}
+
 func requestDrawCard(t *testing.T, router *gin.Engine, id string, queryParameters string) (int, DrawCardResponse) {
 	responseWriter := httptest.NewRecorder()
 	request, _ := http.NewRequest("POST", fmt.Sprintf("/decks/%s/cards/draw"+queryParameters, id), nil)
@@ -174,3 +1207,14 @@ func requestDrawCard(t *testing.T, router *gin.Engine, id string, queryParameter
 	}
 	return responseWriter.Code, drawCardResponse
 }
+
+func expireDeck(t *testing.T, repository DeckRepository, id string) {
+	err := repository.Update(id, func(deck *decks.PlayableDeck) error {
+		expiresAt := time.Now().Add(-time.Second)
+		deck.ExpiresAt = &expiresAt
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to expire the test deck: %s", err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// DeckSweeper evicts the expired decks from a DeckRepository.
+// An expired deck is evicted once it has been expired for longer than the retention period, so
+// that it is reported as expired rather than unknown in the meantime.
+type DeckSweeper struct {
+	repository DeckRepository
+	retention  time.Duration
+}
+
+// NewDeckSweeper creates and returns a DeckSweeper evicting the decks of repository which have been
+// expired for longer than retention.
+func NewDeckSweeper(repository DeckRepository, retention time.Duration) *DeckSweeper {
+	return &DeckSweeper{repository: repository, retention: retention}
+}
+
+// Sweep evicts the decks which have been expired for longer than the retention period at now,
+// and returns the number of evicted decks.
+func (sweeper *DeckSweeper) Sweep(now time.Time) (int, error) {
+	storedDecks, err := sweeper.repository.List()
+	if err != nil {
+		return 0, err
+	}
+	evictedDeckCount := 0
+	for _, deck := range storedDecks {
+		if !deck.IsExpired(now.Add(-sweeper.retention)) {
+			continue
+		}
+		err := sweeper.repository.Delete(deck.ID.String())
+		if err == nil {
+			evictedDeckCount += 1
+		} else if !errors.Is(err, ErrDeckNotFound) {
+			return evictedDeckCount, err
+		}
+	}
+	return evictedDeckCount, nil
+}
+
+// Run sweeps the repository every interval until ctx is done.
+func (sweeper *DeckSweeper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			evictedDeckCount, err := sweeper.Sweep(now)
+			if err != nil {
+				log.Printf("Failed to evict the expired decks: %s", err)
+			}
+			if evictedDeckCount > 0 {
+				log.Printf("Evicted %d expired decks", evictedDeckCount)
+			}
+		}
+	}
+}
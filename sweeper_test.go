@@ -0,0 +1,39 @@
+package main
+
+import (
+	"croupier.io/cards"
+	"croupier.io/decks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSweep(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	repository := NewMemoryDeckRepository()
+	sweeper := NewDeckSweeper(repository, time.Hour)
+
+	permanentDeck := newTestDeck(t)
+	activeDeck := newTestDeckWithTTL(t, now.Add(-30*time.Second), 60)
+	recentlyExpiredDeck := newTestDeckWithTTL(t, now.Add(-30*time.Minute), 60)
+	expiredDeck := newTestDeckWithTTL(t, now.Add(-2*time.Hour), 60)
+	for _, deck := range []*decks.PlayableDeck{permanentDeck, activeDeck, recentlyExpiredDeck, expiredDeck} {
+		_ = repository.Create(deck)
+	}
+
+	evictedDeckCount, err := sweeper.Sweep(now)
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, 1, evictedDeckCount, "expected a single evicted deck")
+
+	storedDecks, _ := repository.List()
+	assert.ElementsMatch(t, []*decks.PlayableDeck{permanentDeck, activeDeck, recentlyExpiredDeck}, storedDecks)
+}
+
+func newTestDeckWithTTL(t *testing.T, touchedAt time.Time, ttl int) *decks.PlayableDeck {
+	deck, err := decks.CreateDeck(decks.CreationRequest{PlayingType: cards.French, TTL: ttl}, nil)
+	if err != nil {
+		t.Fatalf("unable to create the test deck: %s", err)
+	}
+	deck.Touch(touchedAt)
+	return deck
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"errors"
+	"sync"
+)
+
+// ErrTemplateNotFound is returned by a TemplateRepository when no Template is associated with an ID.
+var ErrTemplateNotFound = errors.New("template not found")
+
+// ErrTemplateAlreadyExists is returned by a TemplateRepository when a Template is already associated with an ID.
+var ErrTemplateAlreadyExists = errors.New("template already exists")
+
+// TemplateRepository is the interface that wraps the methods used to store and retrieve deck
+// templates.
+// Any implementation must be safe for concurrent use.
+//
+// Create stores a new Template.
+// Create must fail with ErrTemplateAlreadyExists if a Template is already associated with the
+// template ID.
+//
+// Get retrieves a copy of the Template associated with an ID.
+// Get must fail with ErrTemplateNotFound if no Template is associated with the ID.
+//
+// Delete removes the Template associated with an ID.
+// Delete must fail with ErrTemplateNotFound if no Template is associated with the ID.
+//
+// List retrieves a copy of all the stored templates.
+type TemplateRepository interface {
+	Create(template *decks.Template) error
+	Get(id string) (*decks.Template, error)
+	Delete(id string) error
+	List() ([]*decks.Template, error)
+}
+
+// MemoryTemplateRepository is a TemplateRepository keeping the templates in memory.
+// The templates stored in a MemoryTemplateRepository are lost once the API stops.
+type MemoryTemplateRepository struct {
+	mutex     sync.RWMutex
+	templates map[string]*decks.Template
+}
+
+var _ TemplateRepository = &MemoryTemplateRepository{}
+
+// NewMemoryTemplateRepository creates and returns an empty MemoryTemplateRepository.
+func NewMemoryTemplateRepository() *MemoryTemplateRepository {
+	return &MemoryTemplateRepository{templates: make(map[string]*decks.Template)}
+}
+
+// Create stores a copy of a new Template.
+// Create fails with ErrTemplateAlreadyExists if a Template is already associated with the
+// template ID.
+func (repository *MemoryTemplateRepository) Create(template *decks.Template) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	id := template.ID.String()
+	if _, isPresent := repository.templates[id]; isPresent {
+		return ErrTemplateAlreadyExists
+	}
+	repository.templates[id] = template.Clone()
+	return nil
+}
+
+// Get retrieves a copy of the Template associated with id.
+// Get fails with ErrTemplateNotFound if no Template is associated with id.
+func (repository *MemoryTemplateRepository) Get(id string) (*decks.Template, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	template, isPresent := repository.templates[id]
+	if !isPresent {
+		return nil, ErrTemplateNotFound
+	}
+	return template.Clone(), nil
+}
+
+// Delete removes the Template associated with id.
+// Delete fails with ErrTemplateNotFound if no Template is associated with id.
+func (repository *MemoryTemplateRepository) Delete(id string) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if _, isPresent := repository.templates[id]; !isPresent {
+		return ErrTemplateNotFound
+	}
+	delete(repository.templates, id)
+	return nil
+}
+
+// List retrieves a copy of all the stored templates.
+func (repository *MemoryTemplateRepository) List() ([]*decks.Template, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	storedTemplates := make([]*decks.Template, 0, len(repository.templates))
+	for _, template := range repository.templates {
+		storedTemplates = append(storedTemplates, template.Clone())
+	}
+	return storedTemplates, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMemoryTemplateRepositoryCreate(t *testing.T) {
+	repository := NewMemoryTemplateRepository()
+	template := newTestTemplate(t)
+
+	assert.Nil(t, repository.Create(template), "expected no error upon creation")
+	assert.ErrorIs(t, repository.Create(template), ErrTemplateAlreadyExists)
+}
+
+func TestMemoryTemplateRepositoryGet(t *testing.T) {
+	repository := NewMemoryTemplateRepository()
+	template := newTestTemplate(t)
+	_ = repository.Create(template)
+
+	storedTemplate, err := repository.Get(template.ID.String())
+	assert.Nil(t, err, "expected no error")
+	assert.Equal(t, template, storedTemplate, "expected identical templates")
+
+	storedTemplate.Name = "Modified"
+	storedTemplate, _ = repository.Get(template.ID.String())
+	assert.Equal(t, template.Name, storedTemplate.Name, "expected the stored template to be untouched")
+
+	storedTemplate, err = repository.Get("unknown_id")
+	assert.Nil(t, storedTemplate, "expected no template")
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestMemoryTemplateRepositoryDelete(t *testing.T) {
+	repository := NewMemoryTemplateRepository()
+	template := newTestTemplate(t)
+	id := template.ID.String()
+	assert.ErrorIs(t, repository.Delete(id), ErrTemplateNotFound)
+
+	_ = repository.Create(template)
+	assert.Nil(t, repository.Delete(id), "expected no error upon deletion")
+	_, err := repository.Get(id)
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestMemoryTemplateRepositoryList(t *testing.T) {
+	repository := NewMemoryTemplateRepository()
+	templates, err := repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.Empty(t, templates, "expected no template")
+
+	firstTemplate, secondTemplate := newTestTemplate(t), newTestTemplate(t)
+	_ = repository.Create(firstTemplate)
+	_ = repository.Create(secondTemplate)
+	templates, err = repository.List()
+	assert.Nil(t, err, "expected no error")
+	assert.ElementsMatch(t, []*decks.Template{firstTemplate, secondTemplate}, templates)
+}
+
+func newTestTemplate(t *testing.T) *decks.Template {
+	template, err := decks.NewTemplate(decks.Template{
+		Name:   "Lost Cities",
+		Suits:  []string{"RED", "BLUE"},
+		Values: []string{"2", "3", "WAGER"},
+		Cards: []decks.TemplateCard{
+			{Suit: "SPECIAL", Value: "DRAGON", Code: "DR", Copies: 2, Attributes: map[string]string{"cost": "3"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("test template creation failure: %s", err)
+	}
+	return template
+}
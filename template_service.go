@@ -0,0 +1,90 @@
+package main
+
+import (
+	"croupier.io/decks"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"sort"
+)
+
+// templateService is the representation of the route handlers associated with deck templates.
+type templateService struct {
+	repository TemplateRepository
+}
+
+// newTemplateService creates and returns a templateService storing the templates in repository.
+func newTemplateService(repository TemplateRepository) *templateService {
+	return &templateService{repository: repository}
+}
+
+// createTemplate validates and stores a Template.
+func (service *templateService) createTemplate(context *gin.Context) {
+	var request decks.Template
+	if err := context.BindJSON(&request); err != nil {
+		log.Printf("Failed to get the template creation request: %s", err)
+		context.JSON(http.StatusBadRequest, gin.H{"message": "unable to create the template"})
+		return
+	}
+	template, err := decks.NewTemplate(request)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	if err := service.repository.Create(template); err != nil {
+		log.Printf("Failed to store the template: %s", err)
+		context.JSON(http.StatusInternalServerError, gin.H{"message": "unable to create the template"})
+		return
+	}
+	context.JSON(http.StatusCreated, template)
+}
+
+// listTemplates retrieves all the stored templates, sorted by name.
+func (service *templateService) listTemplates(context *gin.Context) {
+	templates, err := service.repository.List()
+	if !service.handleTemplateError(context, err, "unable to list the templates") {
+		return
+	}
+	sort.SliceStable(templates, func(i, j int) bool {
+		if templates[i].Name != templates[j].Name {
+			return templates[i].Name < templates[j].Name
+		}
+		return templates[i].ID.String() < templates[j].ID.String()
+	})
+	context.JSON(http.StatusOK, gin.H{"templates": templates})
+}
+
+// openTemplate finds a Template associated with a provided ID, if any.
+func (service *templateService) openTemplate(context *gin.Context) {
+	template, err := service.repository.Get(context.Param("id"))
+	if !service.handleTemplateError(context, err, "unable to retrieve the template") {
+		return
+	}
+	context.JSON(http.StatusOK, template)
+}
+
+// deleteTemplate removes a Template associated with a provided ID, if any.
+// The decks already created out of the Template are untouched.
+func (service *templateService) deleteTemplate(context *gin.Context) {
+	err := service.repository.Delete(context.Param("id"))
+	if !service.handleTemplateError(context, err, "unable to delete the template") {
+		return
+	}
+	context.Status(http.StatusNoContent)
+}
+
+// handleTemplateError writes in context the response associated with err, if any.
+// handleTemplateError returns true if err is nil, so that the route handler can carry on.
+func (service *templateService) handleTemplateError(context *gin.Context, err error, failureMessage string) bool {
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, ErrTemplateNotFound) {
+		context.JSON(http.StatusNotFound, gin.H{"message": "unable to find the template"})
+		return false
+	}
+	log.Printf("Failed to access the template: %s", err)
+	context.JSON(http.StatusInternalServerError, gin.H{"message": failureMessage})
+	return false
+}
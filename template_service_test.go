@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"croupier.io/cards"
+	"croupier.io/decks"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type TemplateListResponse struct {
+	Templates []decks.Template `json:"templates"`
+}
+
+func TestCreateTemplate(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, template := requestCreateTemplate(t, router, newTestTemplate(t))
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.NotEqual(t, uuid.Nil, template.ID, "expected a non-empty template ID")
+	assert.Equal(t, "Lost Cities", template.Name)
+
+	statusCode, openedTemplate := requestOpenTemplate(t, router, template.ID.String())
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, template, openedTemplate, "expected the stored template")
+}
+
+func TestCreateInvalidTemplate(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+
+	statusCode, _ := requestCreateTemplate(t, router, decks.Template{Name: "Empty"})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+
+	statusCode, _ = requestCreateTemplate(t, router, "invalid body")
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func TestListTemplates(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	_, secondTemplate := requestCreateTemplate(t, router, decks.Template{Name: "Zoo", Suits: []string{"ANIMAL"}, Values: []string{"LION"}})
+	_, firstTemplate := requestCreateTemplate(t, router, newTestTemplate(t))
+
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/templates", nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var response TemplateListResponse
+	_ = json.Unmarshal(responseWriter.Body.Bytes(), &response)
+	assert.Equal(t, http.StatusOK, responseWriter.Code)
+	assert.Equal(t, []decks.Template{firstTemplate, secondTemplate}, response.Templates, "expected the templates sorted by name")
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	_, template := requestCreateTemplate(t, router, newTestTemplate(t))
+	id := template.ID.String()
+	_, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{TemplateID: id})
+
+	assert.Equal(t, http.StatusNoContent, requestDeleteTemplate(router, id))
+	assert.Equal(t, http.StatusNotFound, requestDeleteTemplate(router, id))
+	statusCode, _ := requestOpenTemplate(t, router, id)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	statusCode, _ = requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, http.StatusOK, statusCode, "expected the decks of the template to be kept")
+}
+
+func TestCreateDeckFromTemplate(t *testing.T) {
+	router := NewRouter(Config{}, NewMemoryDeckRepository(), NewMemoryTemplateRepository())
+	_, template := requestCreateTemplate(t, router, newTestTemplate(t))
+	id := template.ID.String()
+
+	statusCode, creationResponse := requestCreateDeck(t, router, "", &decks.CreationRequest{TemplateID: id, Shuffled: true})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	assert.Equal(t, 8, creationResponse.Remaining)
+	_, playingDeck := requestOpenDeck(t, router, creationResponse.DeckID.String())
+	assert.Equal(t, cards.Custom, playingDeck.Type)
+	assert.Equal(t, id, playingDeck.TemplateID)
+
+	statusCode, creationResponse = requestCreateDeck(t, router, "?cards=DR", &decks.CreationRequest{PlayingType: cards.Custom, TemplateID: id})
+	assert.Equal(t, http.StatusCreated, statusCode)
+	_, drawResponse := requestCardOperation(t, router, creationResponse.DeckID.String(), "draw", "?count=1")
+	assert.Equal(t, "DR", drawResponse.Cards[0].Code)
+	assert.Equal(t, map[string]string{"cost": "3"}, drawResponse.Cards[0].Attributes, "expected the card attributes")
+
+	statusCode, _ = requestCreateDeck(t, router, "", &decks.CreationRequest{TemplateID: uuid.New().String()})
+	assert.Equal(t, http.StatusNotFound, statusCode)
+
+	statusCode, _ = requestCreateDeck(t, router, "", &decks.CreationRequest{PlayingType: cards.Custom})
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
+
+func requestCreateTemplate(t *testing.T, router *gin.Engine, templateRequest interface{}) (int, decks.Template) {
+	byteBody, err := json.Marshal(templateRequest)
+	if err != nil {
+		t.Fail()
+	}
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/templates", bytes.NewBuffer(byteBody))
+	router.ServeHTTP(responseWriter, request)
+
+	var template decks.Template
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &template); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, template
+}
+
+func requestOpenTemplate(t *testing.T, router *gin.Engine, id string) (int, decks.Template) {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/templates/"+id, nil)
+	router.ServeHTTP(responseWriter, request)
+
+	var template decks.Template
+	if err := json.Unmarshal(responseWriter.Body.Bytes(), &template); err != nil {
+		t.Fail()
+	}
+	return responseWriter.Code, template
+}
+
+func requestDeleteTemplate(router *gin.Engine, id string) int {
+	responseWriter := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/templates/"+id, nil)
+	router.ServeHTTP(responseWriter, request)
+	return responseWriter.Code
+}